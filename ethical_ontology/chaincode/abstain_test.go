@@ -0,0 +1,43 @@
+package reputation
+
+import "testing"
+
+// abstainDAO has one for-vote and one abstention of equal weight (9 each)
+// against a minimum turnout of 15.
+func abstainDAO(t *testing.T, countsTowardQuorum bool, inApprovalDenominator bool) *DAOContract {
+	t.Helper()
+	dao, _, _ := newTestDAO(t, 0.6, map[string]int{"f": 81, "x": 81})
+	dao.SetAbstainPolicy(countsTowardQuorum, inApprovalDenominator)
+	dao.SetMinTurnout(15)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "f", true, 1)
+	dao.VoteAbstain("p", "x", 1)
+	return dao
+}
+
+func TestAbstainPolicy(t *testing.T) {
+	cases := []struct {
+		name                              string
+		countsTowardQuorum, inDenominator bool
+		passes                            bool
+	}{
+		{"ignored", false, false, false},
+		{"counts toward quorum", true, false, true},
+		{"also dilutes approval", true, true, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dao := abstainDAO(t, tc.countsTowardQuorum, tc.inDenominator)
+			if got := dao.Enact("p"); got != tc.passes {
+				t.Fatalf("Enact = %v, want %v", got, tc.passes)
+			}
+		})
+	}
+}
+
+func TestAbstentionsInResults(t *testing.T) {
+	results, ok := abstainDAO(t, true, true).GetProposalResults("p")
+	if !ok || results.Approval != 0.5 || results.Turnout != 18 {
+		t.Fatalf("results = %+v", results)
+	}
+}
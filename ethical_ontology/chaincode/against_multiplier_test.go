@@ -0,0 +1,25 @@
+package reputation
+
+import "testing"
+
+func TestAgainstMultiplier(t *testing.T) {
+	for _, tc := range []struct {
+		multiplier float64
+		passes     bool
+	}{
+		{1, true},  // 18 for, 9 against
+		{2, false}, // 18 for, 18 against
+	} {
+		dao, _, _ := newTestDAO(t, 0.6, map[string]int{"f": 81, "g": 81})
+		dao.SetAgainstMultiplier(tc.multiplier)
+		dao.ProposeRule("p", "a valid description", "f")
+		dao.Vote("p", "f", true, 2)
+		dao.Vote("p", "g", false, 1)
+		if results, _ := dao.GetProposalResults("p"); results.VotesAgainst != 9*tc.multiplier {
+			t.Fatalf("multiplier %v: against = %v", tc.multiplier, results.VotesAgainst)
+		}
+		if got := dao.Enact("p"); got != tc.passes {
+			t.Fatalf("multiplier %v: Enact = %v, want %v", tc.multiplier, got, tc.passes)
+		}
+	}
+}
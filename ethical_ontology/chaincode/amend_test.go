@@ -0,0 +1,31 @@
+package reputation
+
+import "testing"
+
+func TestAmendProposalKeepsHistory(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.6, map[string]int{"f": 81, "g": 81})
+	dao.ProposeRule("p", "version 1", "f")
+	if dao.AmendProposal("p", "g", "version by g") {
+		t.Fatal("only the proposer may amend")
+	}
+	if !dao.AmendProposal("p", "f", "version 2") || !dao.AmendProposal("p", "f", "version 3") {
+		t.Fatal("proposer could not amend before any vote")
+	}
+	dao.Vote("p", "g", true, 1)
+	if dao.AmendProposal("p", "f", "version 4") {
+		t.Fatal("amended after the vote limit was reached")
+	}
+	prop := dao.GetProposal("p")
+	if prop.Description != "version 3" || len(prop.History) != 2 || prop.History[0] != "version 1" || prop.History[1] != "version 2" {
+		t.Fatalf("description %q, history %v", prop.Description, prop.History)
+	}
+}
+
+func TestAmendProposalValidatesDescription(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.6, map[string]int{"f": 81})
+	dao.SetDescriptionRules(DescriptionRules{MinLength: 5})
+	dao.ProposeRule("p", "version 1", "f")
+	if dao.AmendProposal("p", "f", "v2") {
+		t.Fatal("amendment bypassed the description rules")
+	}
+}
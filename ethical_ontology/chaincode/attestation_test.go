@@ -0,0 +1,29 @@
+package reputation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAttestationSurvivesSerialization(t *testing.T) {
+	rep := NewReputationContract()
+	rep.MintTokenWithAttestation("a", 90, "sha256:abc")
+	rep.MintToken("b", 90)
+	if got := rep.Events()[0].Details["attestation"]; got != "sha256:abc" {
+		t.Fatalf("mint event attestation = %q", got)
+	}
+	blob, err := json.Marshal(rep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := NewReputationContract()
+	if err := json.Unmarshal(blob, loaded); err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.GetAttestation("a"); got != "sha256:abc" {
+		t.Fatalf("loaded attestation = %q", got)
+	}
+	if loaded.GetAttestation("b") != "" || loaded.GetReputation("b") != 90 {
+		t.Fatal("agent minted without evidence gained an attestation")
+	}
+}
@@ -0,0 +1,82 @@
+package reputation
+
+import "sort"
+
+// AuditEntry is one sampled proposal with everything needed to check it.
+type AuditEntry struct {
+	Proposal Proposal
+	Voters   []string // sorted
+	Results  ProposalResults
+	// Events is the proposal's trail in emission order: events about it and
+	// rewards citing it.
+	Events []Event
+}
+
+// AuditReport is a reproducible sample of governance activity.
+type AuditReport struct {
+	Seed    []byte
+	Entries []AuditEntry // in draw order
+	// Population is the number of proposals that could have been drawn and
+	// TotalReputation the participating reputation behind them.
+	Population      int
+	TotalReputation int
+}
+
+// AuditSample draws up to n distinct proposals, each with probability
+// proportional to the reputation that participated in it, using only seed
+// for randomness: the same seed over the same state always yields the same
+// report, so an auditor can verify the selection independently. Proposals
+// without participating reputation are never drawn.
+func (d *DAOContract) AuditSample(n int, seed []byte) AuditReport {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	type candidate struct {
+		prop   *Proposal
+		weight uint64
+	}
+	var pool []candidate
+	var total uint64
+	for _, prop := range d.proposals {
+		if weight := d.participatingReputation(prop); weight > 0 {
+			pool = append(pool, candidate{prop, uint64(weight)})
+			total += uint64(weight)
+		}
+	}
+	// Start from a canonical order so map iteration can't leak into the draw
+	sort.Slice(pool, func(i, j int) bool { return pool[i].prop.ID < pool[j].prop.ID })
+	report := AuditReport{
+		Seed:            append([]byte(nil), seed...),
+		Population:      len(pool),
+		TotalReputation: int(total),
+	}
+	events := d.Events()
+	for draw := 0; draw < n && len(pool) > 0; draw++ {
+		target := d.seededIndex(seed, draw) % total
+		pick := 0
+		for target >= pool[pick].weight {
+			target -= pool[pick].weight
+			pick++
+		}
+		prop := pool[pick].prop
+		total -= pool[pick].weight
+		pool = append(pool[:pick], pool[pick+1:]...)
+		report.Entries = append(report.Entries, AuditEntry{
+			Proposal: prop.clone(),
+			Voters:   prop.sortedVoters(),
+			Results:  d.tally(prop),
+			Events:   proposalEvents(events, prop.ID),
+		})
+	}
+	return report
+}
+
+// proposalEvents filters events down to those about proposalID.
+func proposalEvents(events []Event, proposalID string) []Event {
+	var trail []Event
+	for _, e := range events {
+		if e.Subject == proposalID || e.Details["proposal"] == proposalID {
+			trail = append(trail, e)
+		}
+	}
+	return trail
+}
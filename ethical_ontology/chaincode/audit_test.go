@@ -0,0 +1,63 @@
+package reputation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func auditDAO(t *testing.T) *DAOContract {
+	t.Helper()
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"a": 100, "b": 90, "c": 85})
+	rep.SetReputationCap(0)
+	dao.SetAuthorReward(2)
+	for _, id := range []string{"p1", "p2", "p3", "p4", "p5"} {
+		dao.ProposeRule(id, id+" valid description", "a")
+	}
+	dao.Vote("p1", "a", true, 1)
+	dao.Vote("p2", "b", true, 1)
+	dao.Vote("p2", "c", true, 1)
+	dao.Vote("p3", "c", false, 1)
+	dao.Vote("p4", "a", true, 1)
+	dao.Vote("p4", "b", true, 1)
+	dao.Enact("p4")
+	return dao // p5 has no votes
+}
+
+func TestAuditSampleIsReproducible(t *testing.T) {
+	dao := auditDAO(t)
+	first, second := dao.AuditSample(3, []byte("seed")), dao.AuditSample(3, []byte("seed"))
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("same seed drew different samples")
+	}
+	if len(first.Entries) != 3 || first.Population != 4 {
+		t.Fatalf("%d entries from a population of %d, want 3 from 4", len(first.Entries), first.Population)
+	}
+	seen := map[string]bool{}
+	for _, entry := range first.Entries {
+		if id := entry.Proposal.ID; seen[id] || id == "p5" {
+			t.Fatalf("drew %s twice or without participation", id)
+		}
+		seen[entry.Proposal.ID] = true
+	}
+	all := dao.AuditSample(10, []byte("other"))
+	if len(all.Entries) != 4 {
+		t.Fatalf("oversized sample has %d entries, want the whole population", len(all.Entries))
+	}
+	for _, entry := range all.Entries {
+		if entry.Proposal.ID == "p4" && len(entry.Events) < 2 {
+			t.Fatalf("enacted proposal's trail %v is missing its enactment and reward", entry.Events)
+		}
+	}
+}
+
+func TestAuditSampleWeighsByParticipation(t *testing.T) {
+	dao := auditDAO(t)
+	draws := map[string]int{}
+	for i := 0; i < 2000; i++ {
+		draws[dao.AuditSample(1, []byte{byte(i), byte(i >> 8)}).Entries[0].Proposal.ID]++
+	}
+	// p4 has 190 participating reputation and p3 only 85
+	if draws["p4"] <= draws["p3"] || draws["p5"] != 0 {
+		t.Fatalf("draw counts %v", draws)
+	}
+}
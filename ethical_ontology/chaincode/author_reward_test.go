@@ -0,0 +1,27 @@
+package reputation
+
+import "testing"
+
+func TestAuthorRewardOnEnactment(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.6, map[string]int{"f": 81, "g": 95})
+	dao.SetAuthorReward(10)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.ProposeRule("q", "another valid description", "g")
+	dao.Vote("p", "f", true, 1)
+	dao.Vote("q", "g", false, 1)
+	dao.Enact("p")
+	dao.Enact("q")
+	if got := rep.GetReputation("f"); got != 91 {
+		t.Fatalf("enacted author has %d, want 91", got)
+	}
+	if got := rep.GetReputation("g"); got != 95 {
+		t.Fatalf("rejected author has %d, want 95", got)
+	}
+	// Rewards stop at the reputation cap
+	dao.ProposeRule("r", "a valid description", "g")
+	dao.Vote("r", "g", true, 1)
+	dao.Enact("r")
+	if got := rep.GetReputation("g"); got != 100 {
+		t.Fatalf("capped author has %d, want 100", got)
+	}
+}
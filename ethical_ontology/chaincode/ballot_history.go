@@ -0,0 +1,19 @@
+package reputation
+
+import "sort"
+
+// BallotHistory returns every ballot agentID has cast, on active and decided
+// proposals alike, in the order the proposals were created.
+func (d *DAOContract) BallotHistory(agentID string) []Ballot {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	ids := d.ballotsByAgent[agentID]
+	history := make([]Ballot, 0, len(ids))
+	for _, id := range ids {
+		history = append(history, d.proposals[id].Ballots[agentID])
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return d.proposals[history[i].ProposalID].Sequence < d.proposals[history[j].ProposalID].Sequence
+	})
+	return history
+}
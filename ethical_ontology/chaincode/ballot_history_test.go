@@ -0,0 +1,27 @@
+package reputation
+
+import "testing"
+
+func TestBallotHistoryInProposalOrder(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	dao.ProposeRule("z", "a valid description", "f")
+	dao.ProposeRule("a", "b valid description", "f")
+	dao.ProposeRule("m", "c valid description", "f")
+	dao.Vote("m", "f", true, 1)
+	dao.VoteWithReason("z", "f", VoteAgainst, 2, "bad idea")
+	dao.Vote("a", "f", true, 1)
+	dao.Enact("a")
+	history := dao.BallotHistory("f")
+	if len(history) != 3 {
+		t.Fatalf("%d ballots, want 3", len(history))
+	}
+	if history[0].ProposalID != "z" || history[0].Reason != "bad idea" || history[0].Weight != 2 {
+		t.Fatalf("first entry %+v", history[0])
+	}
+	if history[1].ProposalID != "a" || history[2].ProposalID != "m" {
+		t.Fatalf("history order %v, %v", history[1].ProposalID, history[2].ProposalID)
+	}
+	if len(dao.BallotHistory("nobody")) != 0 {
+		t.Fatal("agent without ballots has a history")
+	}
+}
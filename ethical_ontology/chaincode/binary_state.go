@@ -0,0 +1,329 @@
+package reputation
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// binaryStateVersion leads every binary blob so the layout can evolve;
+// decoders reject versions they don't know. Version 1 stored struct fields
+// by position and broke whenever a field was added; version 2 keys them by
+// name, so fields may be added freely and missing ones decode as zero.
+const binaryStateVersion byte = 2
+
+// v1Fields lists, in order, the fields version 1 wrote for the structs that
+// have grown since. Every other struct is unchanged.
+var v1Fields = map[reflect.Type][]string{
+	reflect.TypeOf(Proposal{}): {
+		"ID", "Category", "Description", "ProposerID", "CreatedAt", "Sequence", "History",
+		"ForWeight", "AgainstWeight", "AbstainWeight", "VotesFor", "VotesAgainst", "VotesAbstain",
+		"Voters", "Ballots", "Status", "Active", "Paused", "Deadline", "EnactedAt", "Challenges",
+		"PolicyName", "FastTrack", "ConflictsWith", "Tags", "AdaptiveTurnout", "EligibleReputation",
+	},
+	reflect.TypeOf(daoState{}): {
+		"Proposals", "ProposalCount", "Quorum", "Delegations", "Splits", "DelegationCap",
+		"MinDelegation", "AbstainCountsTowardQuorum", "AbstainInApprovalDenominator", "MinTurnout",
+		"MinReputationShare", "MinVoters", "AgainstMultiplier", "AuthorReward", "AmendVoteLimit",
+		"RequiredRoles", "DescriptionRules", "Limits", "LastProposed", "VotingPeriod",
+		"ChallengeRules", "FastTrack", "MaxWeightPerEpoch", "EpochLength", "EpochUsage", "Admins",
+		"Breaker", "Events",
+	},
+	reflect.TypeOf(reputationState{}): {
+		"Reputations", "Tokens", "RoleThresholds", "ScoreRules", "ReputationCap", "VouchesRequired",
+		"VouchMinReputation", "Vouches", "Floors", "Quarantined", "Attestations", "GenesisApplied",
+		"Events",
+	},
+}
+
+var errTruncatedState = errors.New("binary state is truncated")
+
+// MarshalBinary encodes the same state as MarshalJSON in a compact form:
+// exported struct fields keyed by name in declaration order, each value
+// length-prefixed so unknown fields can be skipped, varint numbers and
+// length-prefixed strings, with map keys sorted so every peer writes the
+// same bytes.
+func (c *ReputationContract) MarshalBinary() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return encodeState(c.state())
+}
+
+func (c *ReputationContract) UnmarshalBinary(data []byte) error {
+	var state reputationState
+	if err := decodeState(data, &state); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.restore(state)
+	c.record("UnmarshalBinary", data)
+	return nil
+}
+
+// MarshalBinary is the compact counterpart of MarshalJSON; see
+// ReputationContract.MarshalBinary for the layout.
+func (d *DAOContract) MarshalBinary() ([]byte, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return encodeState(d.state())
+}
+
+// UnmarshalBinary loads state into a contract built with NewDAOContract.
+func (d *DAOContract) UnmarshalBinary(data []byte) error {
+	var state daoState
+	if err := decodeState(data, &state); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("UnmarshalBinary", data)
+	d.restore(state)
+	return nil
+}
+
+func encodeState(state any) ([]byte, error) {
+	buf := []byte{binaryStateVersion}
+	return appendValue(buf, reflect.ValueOf(state))
+}
+
+func decodeState(data []byte, state any) error {
+	if len(data) == 0 {
+		return errTruncatedState
+	}
+	if data[0] != 1 && data[0] != binaryStateVersion {
+		return fmt.Errorf("unsupported binary state version %d", data[0])
+	}
+	dec := stateDecoder{positional: data[0] == 1}
+	rest, err := dec.readValue(data[1:], reflect.ValueOf(state).Elem())
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return fmt.Errorf("%d trailing bytes after binary state", len(rest))
+	}
+	return nil
+}
+
+func appendValue(buf []byte, v reflect.Value) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	case reflect.Int, reflect.Int64:
+		return binary.AppendVarint(buf, v.Int()), nil
+	case reflect.Float64:
+		return binary.BigEndian.AppendUint64(buf, math.Float64bits(v.Float())), nil
+	case reflect.String:
+		buf = binary.AppendUvarint(buf, uint64(v.Len()))
+		return append(buf, v.String()...), nil
+	case reflect.Pointer:
+		if v.IsNil() {
+			return append(buf, 0), nil
+		}
+		return appendValue(append(buf, 1), v.Elem())
+	case reflect.Slice:
+		buf = binary.AppendUvarint(buf, uint64(v.Len()))
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return append(buf, v.Bytes()...), nil
+		}
+		var err error
+		for i := 0; i < v.Len() && err == nil; i++ {
+			buf, err = appendValue(buf, v.Index(i))
+		}
+		return buf, err
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("cannot encode map keyed by %s", v.Type().Key())
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		buf = binary.AppendUvarint(buf, uint64(len(keys)))
+		var err error
+		for _, key := range keys {
+			if buf, err = appendValue(buf, key); err != nil {
+				return nil, err
+			}
+			if buf, err = appendValue(buf, v.MapIndex(key)); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case reflect.Struct:
+		fields := exportedFields(v.Type())
+		buf = binary.AppendUvarint(buf, uint64(len(fields)))
+		for _, field := range fields {
+			value, err := appendValue(nil, v.FieldByIndex(field.Index))
+			if err != nil {
+				return nil, err
+			}
+			buf = binary.AppendUvarint(buf, uint64(len(field.Name)))
+			buf = append(buf, field.Name...)
+			buf = binary.AppendUvarint(buf, uint64(len(value)))
+			buf = append(buf, value...)
+		}
+		return buf, nil
+	default:
+		return nil, fmt.Errorf("cannot encode %s", v.Type())
+	}
+}
+
+func exportedFields(t reflect.Type) []reflect.StructField {
+	var fields []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.IsExported() {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// stateDecoder reads either layout; positional selects version 1.
+type stateDecoder struct {
+	positional bool
+}
+
+func (dec stateDecoder) readValue(data []byte, v reflect.Value) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if len(data) < 1 {
+			return nil, errTruncatedState
+		}
+		v.SetBool(data[0] == 1)
+		return data[1:], nil
+	case reflect.Int, reflect.Int64:
+		n, size := binary.Varint(data)
+		if size <= 0 {
+			return nil, errTruncatedState
+		}
+		v.SetInt(n)
+		return data[size:], nil
+	case reflect.Float64:
+		if len(data) < 8 {
+			return nil, errTruncatedState
+		}
+		v.SetFloat(math.Float64frombits(binary.BigEndian.Uint64(data)))
+		return data[8:], nil
+	case reflect.String:
+		n, rest, err := readLength(data)
+		if err != nil {
+			return nil, err
+		}
+		v.SetString(string(rest[:n]))
+		return rest[n:], nil
+	case reflect.Pointer:
+		if len(data) < 1 {
+			return nil, errTruncatedState
+		}
+		if data[0] == 0 {
+			return data[1:], nil
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		return dec.readValue(data[1:], v.Elem())
+	case reflect.Slice:
+		n, rest, err := readLength(data)
+		if err != nil || n == 0 {
+			return rest, err
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes(append([]byte(nil), rest[:n]...))
+			return rest[n:], nil
+		}
+		v.Set(reflect.MakeSlice(v.Type(), n, n))
+		for i := 0; i < n && err == nil; i++ {
+			rest, err = dec.readValue(rest, v.Index(i))
+		}
+		return rest, err
+	case reflect.Map:
+		n, rest, err := readLength(data)
+		if err != nil || n == 0 {
+			return rest, err
+		}
+		v.Set(reflect.MakeMapWithSize(v.Type(), n))
+		for i := 0; i < n; i++ {
+			key := reflect.New(v.Type().Key()).Elem()
+			if rest, err = dec.readValue(rest, key); err != nil {
+				return nil, err
+			}
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if rest, err = dec.readValue(rest, elem); err != nil {
+				return nil, err
+			}
+			v.SetMapIndex(key, elem)
+		}
+		return rest, nil
+	case reflect.Struct:
+		if dec.positional {
+			return dec.readPositional(data, v)
+		}
+		return dec.readKeyed(data, v)
+	default:
+		return nil, fmt.Errorf("cannot decode %s", v.Type())
+	}
+}
+
+// readKeyed reads a version 2 struct, skipping fields this build doesn't
+// know and leaving absent ones at their zero value.
+func (dec stateDecoder) readKeyed(data []byte, v reflect.Value) ([]byte, error) {
+	n, rest, err := readLength(data)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		size, nameEnd, err := readLength(rest)
+		if err != nil {
+			return nil, err
+		}
+		name := string(nameEnd[:size])
+		size, valueEnd, err := readLength(nameEnd[size:])
+		if err != nil {
+			return nil, err
+		}
+		value := valueEnd[:size]
+		rest = valueEnd[size:]
+		field, known := v.Type().FieldByName(name)
+		if !known || !field.IsExported() || len(field.Index) != 1 {
+			continue
+		}
+		left, err := dec.readValue(value, v.Field(field.Index[0]))
+		if err != nil {
+			return nil, err
+		}
+		if len(left) > 0 {
+			return nil, fmt.Errorf("%d trailing bytes in field %s", len(left), name)
+		}
+	}
+	return rest, nil
+}
+
+// readPositional reads a version 1 struct: the fields it had then, in order.
+func (dec stateDecoder) readPositional(data []byte, v reflect.Value) ([]byte, error) {
+	names, grown := v1Fields[v.Type()]
+	if !grown {
+		for _, field := range exportedFields(v.Type()) {
+			names = append(names, field.Name)
+		}
+	}
+	var err error
+	for _, name := range names {
+		if data, err = dec.readValue(data, v.FieldByName(name)); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// readLength reads a count prefix, refusing counts larger than the bytes
+// left so corrupt input can't force a huge allocation.
+func readLength(data []byte) (int, []byte, error) {
+	n, size := binary.Uvarint(data)
+	if size <= 0 || n > uint64(len(data)-size) {
+		return 0, nil, errTruncatedState
+	}
+	return int(n), data[size:], nil
+}
@@ -0,0 +1,164 @@
+package reputation
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+)
+
+// Blobs written by the version 1 (positional) codec, before the contracts
+// grew fields such as stakes, commitments and tie rules.
+const (
+	v1ReputationBlob = "" +
+		"01030166a2010167b4010168b801030166010167010168013cb4010100c80100" +
+		"c80100000000010168010000050c746f6b656e5f6d696e746564016600020b61" +
+		"74746573746174696f6e000c7669727475655f73636f72650238310c746f6b65" +
+		"6e5f6d696e746564016700020b6174746573746174696f6e000c766972747565" +
+		"5f73636f72650239300c746f6b656e5f6d696e746564016800020b6174746573" +
+		"746174696f6e000c7669727475655f73636f7265023935127265707574617469" +
+		"6f6e5f736c617368656401680003107072696f725f72657075746174696f6e02" +
+		"393506726561736f6e047370616d0a72657075746174696f6e02393211616765" +
+		"6e745f71756172616e74696e656401680000"
+	v1DAOBlob = "" +
+		"01020101700013612076616c6964206465736372697074696f6e0166b4a7f9ac" +
+		"0d020080a29511e08786090040320000000000004022f9420b3d4ae400000000" +
+		"000000000201660101670102016601700004a201b4a7f9ac0d03796573016701" +
+		"700202b401b4a7f9ac0d0002000000b4a7f9ac0d000000000000000000000000" +
+		"00d6020101710013622076616c6964206465736372697074696f6e0167b4a7f9" +
+		"ac0d040000000000000000000000000000000000000000000000000000000000" +
+		"000001000000000000000101780000000000000000d602043fe0000000000000" +
+		"0101670166000000000000000000000000000000000000000000000000000000" +
+		"0000003ff0000000000000000202096368616c6c656e6765020770726f706f73" +
+		"650214904e010000020166b4a7f9ac0d0167b4a7f9ac0d0000000080c60a0000" +
+		"000000000000003fe5555555555555000000010166010000011070726f706f73" +
+		"616c5f656e61637465640170000106726561736f6e22617070726f76616c2030" +
+		"2e3635206d65657473207468726573686f6c6420302e3530"
+)
+
+func populatedContracts(t *testing.T) (*DAOContract, *ReputationContract) {
+	t.Helper()
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90, "h": 95})
+	rep.Slash("h", 3, "spam")
+	rep.Quarantine("h")
+	dao.AddAdmin("f")
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.ProposeRule("q", "b valid description", "g")
+	dao.VoteWithReason("p", "f", VoteFor, 2, "yes")
+	dao.Vote("p", "g", false, 1)
+	dao.Enact("p")
+	dao.Delegate("g", "f")
+	dao.AddTag("q", "x")
+	return dao, rep
+}
+
+func TestBinaryStateRoundTrip(t *testing.T) {
+	dao, rep := populatedContracts(t)
+	repBlob, err := rep.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	repJSON, _ := json.Marshal(rep)
+	loadedRep := NewReputationContract()
+	if err := loadedRep.UnmarshalBinary(repBlob); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := json.Marshal(loadedRep); !bytes.Equal(got, repJSON) {
+		t.Fatalf("reputation state changed in a round trip:\n%s\n%s", repJSON, got)
+	}
+
+	daoBlob, err := dao.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	daoJSON, _ := json.Marshal(dao)
+	loaded := NewDAOContract(loadedRep, 0)
+	if err := loaded.UnmarshalBinary(daoBlob); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := json.Marshal(loaded); !bytes.Equal(got, daoJSON) {
+		t.Fatalf("DAO state changed in a round trip:\n%s\n%s", daoJSON, got)
+	}
+	if again, _ := loaded.MarshalBinary(); !bytes.Equal(again, daoBlob) {
+		t.Fatal("re-encoding the loaded DAO produced different bytes")
+	}
+}
+
+func TestBinaryStateIsSmallerThanJSON(t *testing.T) {
+	dao, rep := populatedContracts(t)
+	repBlob, _ := rep.MarshalBinary()
+	repJSON, _ := json.Marshal(rep)
+	daoBlob, _ := dao.MarshalBinary()
+	daoJSON, _ := json.Marshal(dao)
+	if len(repBlob) >= len(repJSON) || len(daoBlob) >= len(daoJSON) {
+		t.Fatalf("binary %d/%d bytes, JSON %d/%d bytes", len(repBlob), len(daoBlob), len(repJSON), len(daoJSON))
+	}
+}
+
+func TestBinaryStateRejectsCorruptInput(t *testing.T) {
+	dao, rep := populatedContracts(t)
+	blob, _ := dao.MarshalBinary()
+	target := NewDAOContract(rep, 0)
+	if err := target.UnmarshalBinary(blob[:len(blob)-3]); err == nil {
+		t.Fatal("truncated blob accepted")
+	}
+	if err := target.UnmarshalBinary(append([]byte{9}, blob[1:]...)); err == nil {
+		t.Fatal("unknown version accepted")
+	}
+	if err := target.UnmarshalBinary(nil); err == nil {
+		t.Fatal("empty blob accepted")
+	}
+}
+
+func TestBinaryStateLoadsVersion1(t *testing.T) {
+	repBlob, _ := hex.DecodeString(v1ReputationBlob)
+	daoBlob, _ := hex.DecodeString(v1DAOBlob)
+	rep := NewReputationContract()
+	if err := rep.UnmarshalBinary(repBlob); err != nil {
+		t.Fatalf("version 1 reputation blob: %v", err)
+	}
+	if rep.GetReputation("f") != 81 || rep.GetReputation("h") != 92 || !rep.IsQuarantined("h") {
+		t.Fatalf("reputations f=%d h=%d", rep.GetReputation("f"), rep.GetReputation("h"))
+	}
+	if rep.Config().ScoreRules != NewReputationContract().Config().ScoreRules {
+		t.Fatal("fields absent from version 1 should keep their defaults")
+	}
+	dao := NewDAOContract(rep, 0)
+	if err := dao.UnmarshalBinary(daoBlob); err != nil {
+		t.Fatalf("version 1 DAO blob: %v", err)
+	}
+	p := dao.GetProposal("p")
+	if p == nil || p.Status != StatusPassed || p.Ballots["f"].Reason != "yes" || dao.GetDelegate("g") != "f" {
+		t.Fatalf("proposal p = %+v", p)
+	}
+	if tags := dao.GetProposal("q").Tags; len(tags) != 1 || tags[0] != "x" {
+		t.Fatalf("tags %v", tags)
+	}
+	// Loaded state is written back in the current layout
+	if blob, _ := dao.MarshalBinary(); blob[0] != binaryStateVersion {
+		t.Fatalf("re-encoded as version %d", blob[0])
+	}
+}
+
+func TestBinaryStateSkipsUnknownFields(t *testing.T) {
+	type future struct {
+		Reputations map[string]int
+		Novel       []string
+		Tokens      map[string]bool
+	}
+	blob, err := encodeState(future{
+		Reputations: map[string]int{"a": 90},
+		Novel:       []string{"ignored"},
+		Tokens:      map[string]bool{"a": true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var state reputationState
+	if err := decodeState(blob, &state); err != nil {
+		t.Fatal(err)
+	}
+	if state.Reputations["a"] != 90 || !state.Tokens["a"] {
+		t.Fatalf("decoded %+v", state)
+	}
+}
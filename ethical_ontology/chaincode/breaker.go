@@ -0,0 +1,62 @@
+package reputation
+
+import "strconv"
+
+// BreakerConfig pauses voting on a proposal once more than MaxVotes arrive
+// within Window seconds. A zero MaxVotes disables the breaker.
+type BreakerConfig struct {
+	MaxVotes int
+	Window   int
+}
+
+func (d *DAOContract) SetBreaker(cfg BreakerConfig) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetBreaker", cfg)
+	d.breaker = cfg
+}
+
+// tripBreaker reports whether accepting another vote at now would exceed the
+// configured rate, pausing the proposal and raising an alert if so.
+func (d *DAOContract) tripBreaker(prop *Proposal, now int) bool {
+	if d.breaker.MaxVotes <= 0 {
+		return false
+	}
+	// Ballots from before a resume were already reviewed, so the window
+	// restarts there rather than tripping again on the same burst
+	since := max(now-d.breaker.Window, prop.ResumedAt)
+	recent := 1 // the vote being cast
+	for _, ballot := range prop.Ballots {
+		if ballot.Time > since {
+			recent++
+		}
+	}
+	if recent <= d.breaker.MaxVotes {
+		return false
+	}
+	prop.Paused = true
+	d.emit(Event{
+		Type:    EventVotingPaused,
+		Subject: prop.ID,
+		Details: map[string]string{
+			"recent_votes": strconv.Itoa(recent),
+			"window":       strconv.Itoa(d.breaker.Window),
+		},
+	})
+	return true
+}
+
+// ResumeVoting lifts a breaker pause after manual review.
+func (d *DAOContract) ResumeVoting(proposalID string, adminID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("ResumeVoting", proposalID, adminID)
+	prop, exists := d.lookup(proposalID)
+	if !exists || !prop.Paused || !d.admins[adminID] {
+		return false
+	}
+	prop.Paused = false
+	prop.ResumedAt = d.clock.Now()
+	d.emit(Event{Type: EventVotingResumed, Subject: prop.ID, Actor: adminID})
+	return true
+}
@@ -0,0 +1,60 @@
+package reputation
+
+import (
+	"fmt"
+	"testing"
+)
+
+func breakerDAO(t *testing.T) (*DAOContract, *manualClock) {
+	t.Helper()
+	scores := map[string]int{}
+	for i := 0; i < 8; i++ {
+		scores[fmt.Sprint("agent", i)] = 90
+	}
+	dao, _, clock := newTestDAO(t, 0.5, scores)
+	clock.now = 100
+	dao.AddAdmin("root")
+	dao.SetBreaker(BreakerConfig{MaxVotes: 3, Window: 10})
+	if !dao.ProposeRule("p", "a valid description", "agent0") {
+		t.Fatal("ProposeRule refused")
+	}
+	return dao, clock
+}
+
+func TestBreakerPausesBurst(t *testing.T) {
+	dao, _ := breakerDAO(t)
+	for i := 0; i < 3; i++ {
+		if !dao.Vote("p", fmt.Sprint("agent", i), true, 1) {
+			t.Fatalf("vote %d refused below the limit", i)
+		}
+	}
+	if dao.Vote("p", "agent3", true, 1) {
+		t.Fatal("vote over the limit accepted")
+	}
+	if err := dao.VoteChecked("p", "agent4", VoteFor, 1); err != ErrVotingPaused {
+		t.Fatalf("vote while paused: got %v, want ErrVotingPaused", err)
+	}
+}
+
+func TestResumeRestartsBreakerWindow(t *testing.T) {
+	dao, clock := breakerDAO(t)
+	for i := 0; i < 4; i++ {
+		dao.Vote("p", fmt.Sprint("agent", i), true, 1)
+	}
+	clock.now = 105 // the tripping ballots are still inside the window
+	if dao.ResumeVoting("p", "agent0") {
+		t.Fatal("non-admin resumed voting")
+	}
+	if !dao.ResumeVoting("p", "root") {
+		t.Fatal("admin could not resume voting")
+	}
+	clock.now = 106
+	for i := 3; i < 6; i++ {
+		if !dao.Vote("p", fmt.Sprint("agent", i), true, 1) {
+			t.Fatalf("vote by agent%d after resume refused", i)
+		}
+	}
+	if dao.Vote("p", "agent6", true, 1) {
+		t.Fatal("a fresh burst after resume did not trip the breaker")
+	}
+}
@@ -0,0 +1,24 @@
+package reputation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestProposalIDsAreUniqueAcrossCategories(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	if err := dao.ProposeRuleInCategory("p", "treasury", "a valid description", "f"); err != nil {
+		t.Fatal(err)
+	}
+	err := dao.ProposeRuleInCategory("p", "security", "a valid description", "f")
+	if !errors.Is(err, ErrProposalExists) {
+		t.Fatalf("reused ID in another category: got %v, want ErrProposalExists", err)
+	}
+	if !strings.Contains(err.Error(), "treasury") {
+		t.Fatalf("error %q should name the category already using the ID", err)
+	}
+	if category := dao.GetProposal("p").Category; category != "treasury" {
+		t.Fatalf("collision overwrote the category with %q", category)
+	}
+}
@@ -0,0 +1,84 @@
+package reputation
+
+import "strconv"
+
+// Challenge is a member's formal objection to an enacted proposal.
+type Challenge struct {
+	AgentID    string
+	Reason     string
+	Reputation int
+	Time       int
+}
+
+// ChallengeRules governs disputes. Window is how long after enactment
+// challenges are accepted (zero for no limit). A proposal becomes disputed
+// once it collects Count challenges or challengers holding Reputation in
+// total; a zero threshold is ignored.
+type ChallengeRules struct {
+	Window     int
+	Count      int
+	Reputation int
+}
+
+func (d *DAOContract) SetChallengeRules(rules ChallengeRules) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetChallengeRules", rules)
+	d.challengeRules = rules
+}
+
+// ChallengeProposal records agentID's dispute of an enacted proposal without
+// reversing it, escalating to StatusDisputed when the thresholds are crossed.
+func (d *DAOContract) ChallengeProposal(proposalID string, agentID string, reason string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("ChallengeProposal", proposalID, agentID, reason)
+	prop, exists := d.lookup(proposalID)
+	if !exists || prop.Status != StatusPassed {
+		return false
+	}
+	now := d.clock.Now()
+	if d.challengeRules.Window > 0 && now-prop.EnactedAt > d.challengeRules.Window {
+		return false
+	}
+	if !d.hasRole(agentID, ActionChallenge) {
+		return false
+	}
+	for _, c := range prop.Challenges {
+		if c.AgentID == agentID {
+			return false
+		}
+	}
+	rep := d.reputation.GetReputation(agentID)
+	prop.Challenges = append(prop.Challenges, Challenge{AgentID: agentID, Reason: reason, Reputation: rep, Time: now})
+	d.emit(Event{
+		Type:    EventProposalChallenged,
+		Subject: prop.ID,
+		Actor:   agentID,
+		Details: map[string]string{"reason": reason, "reputation": strconv.Itoa(rep)},
+	})
+	if d.disputeThresholdMet(prop) {
+		prop.setStatus(StatusDisputed)
+		d.emit(Event{
+			Type:    EventProposalDisputed,
+			Subject: prop.ID,
+			Details: map[string]string{"challenges": strconv.Itoa(len(prop.Challenges))},
+		})
+	}
+	return true
+}
+
+func (d *DAOContract) disputeThresholdMet(prop *Proposal) bool {
+	rules := d.challengeRules
+	if rules.Count > 0 && len(prop.Challenges) >= rules.Count {
+		return true
+	}
+	if rules.Reputation > 0 {
+		total := 0
+		for _, c := range prop.Challenges {
+			total += c.Reputation
+		}
+		return total >= rules.Reputation
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package reputation
+
+import "testing"
+
+func enactedForChallenge(t *testing.T, rules ChallengeRules) (*DAOContract, *manualClock) {
+	t.Helper()
+	dao, _, clock := newTestDAO(t, 0.6, map[string]int{"f": 81, "g": 82, "h": 83})
+	dao.SetChallengeRules(rules)
+	dao.ProposeRule("p", "a valid description", "f")
+	if dao.ChallengeProposal("p", "g", "early") {
+		t.Fatal("challenged a proposal before it was enacted")
+	}
+	dao.Vote("p", "f", true, 1)
+	dao.Enact("p")
+	return dao, clock
+}
+
+func TestChallengesEscalateToDispute(t *testing.T) {
+	dao, _ := enactedForChallenge(t, ChallengeRules{Count: 2})
+	if !dao.ChallengeProposal("p", "g", "bad") {
+		t.Fatal("member could not challenge")
+	}
+	if dao.ChallengeProposal("p", "g", "again") {
+		t.Fatal("same agent challenged twice")
+	}
+	if dao.ChallengeProposal("p", "nobody", "x") {
+		t.Fatal("agent without the challenge role challenged")
+	}
+	if status := dao.GetProposal("p").Status; status != StatusPassed {
+		t.Fatalf("one challenge of two made the proposal %v", status)
+	}
+	dao.ChallengeProposal("p", "h", "worse")
+	if status := dao.GetProposal("p").Status; status != StatusDisputed {
+		t.Fatalf("status %v, want disputed", status)
+	}
+}
+
+func TestChallengeReputationThreshold(t *testing.T) {
+	dao, _ := enactedForChallenge(t, ChallengeRules{Reputation: 150})
+	dao.ChallengeProposal("p", "g", "bad")
+	if status := dao.GetProposal("p").Status; status != StatusPassed {
+		t.Fatalf("82 of 150 reputation made the proposal %v", status)
+	}
+	dao.ChallengeProposal("p", "h", "bad")
+	if status := dao.GetProposal("p").Status; status != StatusDisputed {
+		t.Fatalf("status %v, want disputed", status)
+	}
+}
+
+func TestChallengeWindow(t *testing.T) {
+	dao, clock := enactedForChallenge(t, ChallengeRules{Window: 10, Count: 1})
+	clock.now = 11
+	if dao.ChallengeProposal("p", "g", "late") {
+		t.Fatal("challenge accepted after the window closed")
+	}
+}
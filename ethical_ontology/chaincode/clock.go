@@ -0,0 +1,15 @@
+package reputation
+
+import "time"
+
+// Clock supplies the current time in seconds. Chaincode should inject a
+// clock derived from the transaction timestamp so every peer agrees.
+type Clock interface {
+	Now() int
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() int {
+	return int(time.Now().Unix())
+}
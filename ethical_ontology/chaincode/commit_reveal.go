@@ -0,0 +1,129 @@
+package reputation
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+)
+
+// Commitment is a hidden vote awaiting its reveal.
+type Commitment struct {
+	Digest  []byte // VoteCommitment of the eventual ballot
+	Time    int
+	Deposit int // reputation escrowed until the reveal
+}
+
+// SetRevealWindow sets how long after a proposal's deadline commitments may
+// still be revealed. Enact waits for the window to close while any
+// commitment is unrevealed.
+func (d *DAOContract) SetRevealWindow(seconds int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetRevealWindow", seconds)
+	d.revealWindow = seconds
+}
+
+// SetCommitDeposit requires each commitment to escrow amount of the voter's
+// reputation, refunded on a valid reveal and forfeited if the proposal is
+// decided with the commitment unrevealed. Zero, the default, disables it.
+func (d *DAOContract) SetCommitDeposit(amount int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetCommitDeposit", amount)
+	d.commitDeposit = amount
+}
+
+// CommitVote records agentID's hidden vote on an open proposal. The digest
+// is what VoteCommitment returns for the ballot and salt to be revealed.
+// Proposals without a deadline, or a contract without a reveal window,
+// refuse commitments: nothing would hold Enact back for the reveal.
+func (d *DAOContract) CommitVote(proposalID string, agentID string, digest []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("CommitVote", proposalID, agentID, digest)
+	prop, err := d.activeProposal(proposalID)
+	if err != nil {
+		return err
+	}
+	if prop.Deadline == 0 || d.revealWindow <= 0 {
+		return ErrNoRevealWindow
+	}
+	now := d.clock.Now()
+	if err := d.voteBlocker(prop, agentID, now); err != nil {
+		return err
+	}
+	if d.commitDeposit > 0 && !d.reputation.LockStake(agentID, d.commitDeposit) {
+		return ErrInsufficientStake
+	}
+	if prop.Commitments == nil {
+		prop.Commitments = make(map[string]Commitment)
+	}
+	prop.Commitments[agentID] = Commitment{
+		Digest:  append([]byte(nil), digest...),
+		Time:    now,
+		Deposit: d.commitDeposit,
+	}
+	d.emit(Event{
+		Type:    EventVoteCommitted,
+		Subject: prop.ID,
+		Actor:   agentID,
+		Details: map[string]string{"deposit": strconv.Itoa(d.commitDeposit)},
+	})
+	return nil
+}
+
+// RevealVote opens agentID's commitment and casts the ballot it hid,
+// refunding the deposit. It is accepted until the reveal window closes. A
+// reveal that doesn't match keeps the commitment and may be retried.
+func (d *DAOContract) RevealVote(proposalID string, agentID string, choice VoteChoice, weight int, salt []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("RevealVote", proposalID, agentID, choice, weight, salt)
+	prop, err := d.activeProposal(proposalID)
+	if err != nil {
+		return err
+	}
+	commitment, committed := prop.Commitments[agentID]
+	if !committed {
+		return ErrNoCommitment
+	}
+	now := d.clock.Now()
+	if prop.Status == StatusTallying || (prop.Deadline > 0 && now >= prop.Deadline+d.revealWindow) {
+		return ErrVotingClosed
+	}
+	digest := d.digest(appendField(canonicalVoteMessage(prop.ID, agentID, choice, weight), salt))
+	if !bytes.Equal(digest, commitment.Digest) {
+		return ErrCommitmentMismatch
+	}
+	if err := d.ballotBlocker(prop, agentID); err != nil {
+		return err
+	}
+	// Refund first so the ballot carries the voter's full reputation
+	if commitment.Deposit > 0 {
+		d.reputation.SettleStake(agentID, commitment.Deposit, false)
+	}
+	if err := d.castVote(prop, agentID, choice, weight, "", now); err != nil {
+		if commitment.Deposit > 0 {
+			d.reputation.LockStake(agentID, commitment.Deposit)
+		}
+		return err
+	}
+	delete(prop.Commitments, agentID)
+	return nil
+}
+
+// forfeitUnrevealed burns the deposits of commitments still hidden when
+// their proposal is decided.
+func (d *DAOContract) forfeitUnrevealed(prop *Proposal) {
+	agents := make([]string, 0, len(prop.Commitments))
+	for agentID := range prop.Commitments {
+		agents = append(agents, agentID)
+	}
+	sort.Strings(agents)
+	for _, agentID := range agents {
+		if deposit := prop.Commitments[agentID].Deposit; deposit > 0 {
+			d.reputation.SettleStake(agentID, deposit, true)
+		}
+	}
+	prop.Commitments = nil
+}
@@ -0,0 +1,94 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCommitRevealLifecycle(t *testing.T) {
+	dao, rep, clock := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90})
+	clock.now = 100
+	dao.SetVotingPeriod(50)
+	dao.SetRevealWindow(20)
+	dao.SetCommitDeposit(5)
+	dao.ProposeRule("p", "a valid description", "f")
+	salt := []byte("s")
+	if err := dao.CommitVote("p", "f", dao.VoteCommitment("p", "f", VoteFor, 2, salt)); err != nil {
+		t.Fatal(err)
+	}
+	if err := dao.CommitVote("p", "g", dao.VoteCommitment("p", "g", VoteAgainst, 1, salt)); err != nil {
+		t.Fatal(err)
+	}
+	if rep.GetReputation("f") != 76 {
+		t.Fatalf("deposit not escrowed: f holds %d", rep.GetReputation("f"))
+	}
+	if dao.Vote("p", "f", true, 1) {
+		t.Fatal("direct vote accepted over a commitment")
+	}
+	if err := dao.RevealVote("p", "f", VoteFor, 3, salt); !errors.Is(err, ErrCommitmentMismatch) {
+		t.Fatalf("mismatched reveal: got %v", err)
+	}
+
+	clock.now = 160
+	if err := dao.RevealVote("p", "f", VoteFor, 2, salt); err != nil {
+		t.Fatal(err)
+	}
+	if rep.GetReputation("f") != 81 {
+		t.Fatalf("deposit not refunded: f holds %d", rep.GetReputation("f"))
+	}
+	if err := dao.EnactChecked("p"); !errors.Is(err, ErrRevealPending) {
+		t.Fatalf("Enact inside the reveal window: got %v", err)
+	}
+
+	clock.now = 171
+	if err := dao.RevealVote("p", "g", VoteAgainst, 1, salt); !errors.Is(err, ErrVotingClosed) {
+		t.Fatalf("late reveal: got %v", err)
+	}
+	if err := dao.EnactChecked("p"); err != nil {
+		t.Fatal(err)
+	}
+	if rep.GetReputation("g") != 85 || rep.LockedStake("g") != 0 {
+		t.Fatalf("unrevealed deposit not forfeited: g holds %d", rep.GetReputation("g"))
+	}
+}
+
+func TestCommitVoteNeedsRevealWindow(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90})
+	dao.SetCommitDeposit(5)
+	dao.SetRevealWindow(20)
+	dao.ProposeRule("p", "a valid description", "a")
+	digest := dao.VoteCommitment("p", "a", VoteFor, 1, []byte("s"))
+	if err := dao.CommitVote("p", "a", digest); !errors.Is(err, ErrNoRevealWindow) {
+		t.Fatalf("commit on a proposal without a deadline: got %v", err)
+	}
+	dao.Vote("p", "b", true, 1)
+	if err := dao.EnactChecked("p"); err != nil {
+		t.Fatal(err)
+	}
+	if rep.GetReputation("a") != 90 {
+		t.Fatalf("a lost reputation to a refused commitment: %d", rep.GetReputation("a"))
+	}
+
+	dao.SetVotingPeriod(50)
+	dao.SetRevealWindow(0)
+	dao.ProposeRule("q", "b valid description", "a")
+	if err := dao.CommitVote("q", "a", dao.VoteCommitment("q", "a", VoteFor, 1, nil)); !errors.Is(err, ErrNoRevealWindow) {
+		t.Fatalf("commit without a reveal window: got %v", err)
+	}
+}
+
+func TestCommitmentUsesCanonicalProposalID(t *testing.T) {
+	dao, _, clock := newTestDAO(t, 0.5, map[string]int{"a": 90})
+	dao.SetIDRules(IDRules{Trim: true, CaseFold: true})
+	dao.SetVotingPeriod(50)
+	dao.SetRevealWindow(20)
+	dao.ProposeRule("Prop1", "a valid description", "a")
+	salt := []byte("s")
+	if err := dao.CommitVote(" PROP1 ", "a", dao.VoteCommitment("Prop1", "a", VoteFor, 1, salt)); err != nil {
+		t.Fatal(err)
+	}
+	clock.now = 10
+	if err := dao.RevealVote("prop1", "a", VoteFor, 1, salt); err != nil {
+		t.Fatalf("reveal under case folding: %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package reputation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+)
+
+// Tags domain-separate each kind of hashed message from the others.
+const (
+	voteMessageTag     = "ethicsdash/vote/v1"
+	voteReceiptTag     = "ethicsdash/receipt/v1"
+	proposalContentTag = "ethicsdash/proposal/v1"
+	genesisTag         = "ethicsdash/genesis/v1"
+)
+
+// canonicalVoteMessage is the one encoding used whenever a vote is hashed or
+// signed. Every variable-length field is length-prefixed so ("1", "23") and
+// ("12", "3") can never produce the same bytes.
+func canonicalVoteMessage(proposalID string, agentID string, choice VoteChoice, weight int) []byte {
+	msg := make([]byte, 0, len(voteMessageTag)+len(proposalID)+len(agentID)+21)
+	msg = appendField(msg, []byte(voteMessageTag))
+	msg = appendField(msg, []byte(proposalID))
+	msg = appendField(msg, []byte(agentID))
+	msg = append(msg, choiceByte(choice))
+	return binary.BigEndian.AppendUint64(msg, uint64(int64(weight)))
+}
+
+// choiceByte keeps the original for=1/against=0 encoding stable.
+func choiceByte(choice VoteChoice) byte {
+	switch choice {
+	case VoteFor:
+		return 1
+	case VoteAgainst:
+		return 0
+	default:
+		return 2
+	}
+}
+
+func appendField(buf []byte, field []byte) []byte {
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(field)))
+	return append(buf, field...)
+}
+
+// SetHasher selects the hash used for commitments, receipts and content IDs.
+// It defaults to SHA-256 and should be set once, before any activity, so a
+// deployment stays internally consistent.
+func (d *DAOContract) SetHasher(newHash func() hash.Hash) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.newHash = newHash
+}
+
+func (d *DAOContract) digest(msg []byte) []byte {
+	h := d.newHash()
+	h.Write(msg)
+	return h.Sum(nil)
+}
+
+// VoteCommitment is the hash a voter publishes before revealing their vote.
+// The salt keeps low-entropy votes from being brute-forced out of the hash.
+// The proposal ID is hashed in its canonical form, as RevealVote checks it.
+func (d *DAOContract) VoteCommitment(proposalID string, agentID string, choice VoteChoice, weight int, salt []byte) []byte {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	id := d.idRules.canonical(proposalID)
+	if prop, exists := d.lookup(proposalID); exists {
+		id = prop.ID
+	}
+	return d.digest(appendField(canonicalVoteMessage(id, agentID, choice, weight), salt))
+}
+
+// VoteReceipt returns a digest binding agentID's recorded ballot, which the
+// voter can later present to VerifyReceipt.
+func (d *DAOContract) VoteReceipt(proposalID string, agentID string) ([]byte, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	prop, exists := d.lookup(proposalID)
+	if !exists {
+		return nil, false
+	}
+	ballot, voted := prop.Ballots[agentID]
+	if !voted {
+		return nil, false
+	}
+	return d.receipt(prop.ID, agentID, ballot), true
+}
+
+func (d *DAOContract) VerifyReceipt(proposalID string, agentID string, receipt []byte) bool {
+	expected, ok := d.VoteReceipt(proposalID, agentID)
+	return ok && bytes.Equal(expected, receipt)
+}
+
+func (d *DAOContract) receipt(proposalID string, agentID string, ballot Ballot) []byte {
+	msg := appendField(nil, []byte(voteReceiptTag))
+	msg = appendField(msg, canonicalVoteMessage(proposalID, agentID, ballot.Choice, ballot.Weight))
+	msg = binary.BigEndian.AppendUint64(msg, uint64(int64(ballot.Time)))
+	return d.digest(msg)
+}
+
+// contentID is the deterministic proposal ID for a description and proposer.
+func (d *DAOContract) contentID(description string, proposerID string) string {
+	msg := appendField(nil, []byte(proposalContentTag))
+	msg = appendField(msg, []byte(description))
+	msg = appendField(msg, []byte(proposerID))
+	sum := d.digest(msg)
+	return "prop-" + hex.EncodeToString(sum[:16])
+}
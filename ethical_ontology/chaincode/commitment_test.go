@@ -0,0 +1,62 @@
+package reputation
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"hash"
+	"testing"
+)
+
+// FuzzCanonicalVoteMessage checks that distinct votes never share an
+// encoding, including when a field boundary moves ("1", "23" vs "12", "3").
+func FuzzCanonicalVoteMessage(f *testing.F) {
+	f.Add("1", "23", uint8(0), 1, "12", "3", uint8(0), 1)
+	f.Add("p", "a", uint8(1), 2, "p", "a", uint8(1), -2)
+	f.Add("", "ab", uint8(2), 0, "a", "b", uint8(2), 0)
+	f.Fuzz(func(t *testing.T, p1, a1 string, c1 uint8, w1 int, p2, a2 string, c2 uint8, w2 int) {
+		v1, v2 := VoteChoice(c1%3), VoteChoice(c2%3)
+		same := p1 == p2 && a1 == a2 && v1 == v2 && w1 == w2
+		m1, m2 := canonicalVoteMessage(p1, a1, v1, w1), canonicalVoteMessage(p2, a2, v2, w2)
+		if bytes.Equal(m1, m2) != same {
+			t.Fatalf("(%q, %q, %v, %d) and (%q, %q, %v, %d): equal encodings %v", p1, a1, v1, w1, p2, a2, v2, w2, !same)
+		}
+	})
+}
+
+// FuzzVoteCommitment checks that the salt can't be traded against the vote.
+func FuzzVoteCommitment(f *testing.F) {
+	f.Add("p", "a", 1, []byte("salt"), "p", "a", 1, []byte("salt2"))
+	f.Add("p", "a", 12, []byte("3"), "p", "a", 1, []byte("23"))
+	dao := NewDAOContract(NewReputationContract(), 0.5)
+	f.Fuzz(func(t *testing.T, p1, a1 string, w1 int, s1 []byte, p2, a2 string, w2 int, s2 []byte) {
+		// IDs that normalize alike name the same proposal
+		same := dao.idRules.canonical(p1) == dao.idRules.canonical(p2) && a1 == a2 && w1 == w2 && bytes.Equal(s1, s2)
+		c1, c2 := dao.VoteCommitment(p1, a1, VoteFor, w1, s1), dao.VoteCommitment(p2, a2, VoteFor, w2, s2)
+		if bytes.Equal(c1, c2) != same {
+			t.Fatalf("(%q, %q, %d, %q) and (%q, %q, %d, %q): equal commitments %v", p1, a1, w1, s1, p2, a2, w2, s2, !same)
+		}
+	})
+}
+
+func TestHasherSelectsReceiptDigest(t *testing.T) {
+	var receipts [][]byte
+	for _, newHash := range []func() hash.Hash{nil, sha512.New} {
+		dao, _, _ := newTestDAO(t, 0.6, map[string]int{"f": 81})
+		if newHash != nil {
+			dao.SetHasher(newHash)
+		}
+		dao.ProposeRule("p", "a valid description", "f")
+		dao.Vote("p", "f", true, 1)
+		receipt, ok := dao.VoteReceipt("p", "f")
+		if !ok || !dao.VerifyReceipt("p", "f", receipt) {
+			t.Fatal("receipt for a recorded ballot did not verify")
+		}
+		if dao.VerifyReceipt("p", "f", append(receipt, 1)) || dao.VerifyReceipt("p", "g", receipt) {
+			t.Fatal("tampered receipt verified")
+		}
+		receipts = append(receipts, receipt)
+	}
+	if len(receipts[0]) != 32 || len(receipts[1]) != 64 || bytes.Equal(receipts[0], receipts[1][:32]) {
+		t.Fatalf("receipt lengths %d and %d", len(receipts[0]), len(receipts[1]))
+	}
+}
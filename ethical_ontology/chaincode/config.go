@@ -0,0 +1,150 @@
+package reputation
+
+import "sort"
+
+// ReputationConfig is a snapshot of a ReputationContract's tunables.
+type ReputationConfig struct {
+	RoleThresholds     RoleThresholds
+	ScoreRules         ScoreRules
+	ReputationCap      int
+	Soulbound          bool
+	RecoveryAuthority  string
+	VouchesRequired    int
+	VouchMinReputation int
+	OracleConfigured   bool
+	OracleFailure      OracleFailurePolicy
+	ExpertiseCacheTTL  int
+	GenesisKeySet      bool
+	DomainWeights      map[string]float64
+}
+
+func (c *ReputationContract) Config() ReputationConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cfg := ReputationConfig{
+		RoleThresholds:     c.roleThresholds,
+		ScoreRules:         c.scoreRules,
+		ReputationCap:      c.reputationCap,
+		Soulbound:          c.soulbound,
+		RecoveryAuthority:  c.recoveryAuthority,
+		VouchesRequired:    c.vouchesRequired,
+		VouchMinReputation: c.vouchMinReputation,
+		OracleConfigured:   c.oracle != nil,
+		OracleFailure:      c.oracleFailure,
+		ExpertiseCacheTTL:  c.expertiseTTL,
+		GenesisKeySet:      len(c.genesisKey) > 0,
+		DomainWeights:      make(map[string]float64, len(c.domainWeights)),
+	}
+	for domain, weight := range c.domainWeights {
+		cfg.DomainWeights[domain] = weight
+	}
+	return cfg
+}
+
+// GovernanceConfig is a snapshot of every DAOContract tunable in effect,
+// for debugging and audits. Function-valued settings are reported only as
+// enabled or not. Reputation is filled in when the reputation source can
+// report its own configuration.
+type GovernanceConfig struct {
+	Quorum                       float64
+	MinTurnout                   float64
+	MinVoters                    int
+	MinReputationShare           float64
+	AdaptiveQuorum               bool
+	AgainstMultiplier            float64
+	AbstainCountsTowardQuorum    bool
+	AbstainInApprovalDenominator bool
+	RecencyWeighting             bool
+	AuthorReward                 int
+	WinningSideReward            int
+	RevealWindow                 int
+	CommitDeposit                int
+	AmendVoteLimit               int
+	SelfVoting                   bool
+	ElectorateFrozen             bool
+	SponsorRules                 SponsorRules
+	QuorumElectorate             QuorumElectorate
+	SpamRules                    SpamRules
+	TiePolicy                    TiePolicy
+	TieExtension                 int
+	RequiredRoles                map[string]Role
+	DescriptionRules             DescriptionRules
+	IDRules                      IDRules
+	ProposalLimits               ProposalLimits
+	VotingPeriod                 int
+	ChallengeRules               ChallengeRules
+	FastTrack                    FastTrackRules
+	EpochWeightCap               float64
+	EpochLength                  int
+	DelegationCap                DelegationCap
+	MinDelegationReputation      int
+	Breaker                      BreakerConfig
+	RegisteredPolicies           []string
+	Admins                       []string
+	Reputation                   *ReputationConfig
+}
+
+// SetQuorum changes the approval threshold; it applies to every proposal
+// not yet decided.
+func (d *DAOContract) SetQuorum(quorum float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetQuorum", quorum)
+	d.quorum = quorum
+}
+
+func (d *DAOContract) Config() GovernanceConfig {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	cfg := GovernanceConfig{
+		Quorum:                       d.quorum,
+		MinTurnout:                   d.minTurnout,
+		MinVoters:                    d.minVoters,
+		MinReputationShare:           d.minReputationShare,
+		AdaptiveQuorum:               d.quorumCurve != nil,
+		AgainstMultiplier:            d.againstMultiplier,
+		AbstainCountsTowardQuorum:    d.abstainCountsTowardQuorum,
+		AbstainInApprovalDenominator: d.abstainInApprovalDenominator,
+		RecencyWeighting:             d.recencyWeight != nil,
+		AuthorReward:                 d.authorReward,
+		WinningSideReward:            d.winningSideReward,
+		RevealWindow:                 d.revealWindow,
+		CommitDeposit:                d.commitDeposit,
+		AmendVoteLimit:               d.amendVoteLimit,
+		SelfVoting:                   !d.selfVoteDisallowed,
+		ElectorateFrozen:             d.freezeElectorate,
+		SponsorRules:                 d.sponsorRules,
+		QuorumElectorate:             d.electorate,
+		SpamRules:                    d.spamRules,
+		TiePolicy:                    d.tiePolicy,
+		TieExtension:                 d.tieExtension,
+		RequiredRoles:                make(map[string]Role, len(d.requiredRoles)),
+		DescriptionRules:             d.descriptionRules,
+		IDRules:                      d.idRules,
+		ProposalLimits:               d.limits,
+		VotingPeriod:                 d.votingPeriod,
+		ChallengeRules:               d.challengeRules,
+		FastTrack:                    d.fastTrack,
+		EpochWeightCap:               weightToFloat(d.maxWeightPerEpoch),
+		EpochLength:                  d.epochLength,
+		DelegationCap:                d.delegationCap,
+		MinDelegationReputation:      d.minDelegation,
+		Breaker:                      d.breaker,
+	}
+	for action, role := range d.requiredRoles {
+		cfg.RequiredRoles[action] = role
+	}
+	for name := range d.policies {
+		cfg.RegisteredPolicies = append(cfg.RegisteredPolicies, name)
+	}
+	sort.Strings(cfg.RegisteredPolicies)
+	for adminID := range d.admins {
+		cfg.Admins = append(cfg.Admins, adminID)
+	}
+	sort.Strings(cfg.Admins)
+	if source, ok := d.reputation.(interface{ Config() ReputationConfig }); ok {
+		repCfg := source.Config()
+		cfg.Reputation = &repCfg
+	}
+	return cfg
+}
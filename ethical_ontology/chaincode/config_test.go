@@ -0,0 +1,27 @@
+package reputation
+
+import "testing"
+
+func TestConfigReportsParameters(t *testing.T) {
+	rep := NewReputationContract()
+	dao := NewDAOContract(rep, 0.5)
+	dao.SetQuorum(0.6)
+	dao.AddAdmin("z")
+	dao.AddAdmin("a")
+	rep.SetReputationCap(70)
+	cfg := dao.Config()
+	if cfg.Quorum != 0.6 || cfg.Reputation.ReputationCap != 70 {
+		t.Fatalf("quorum %v, cap %d", cfg.Quorum, cfg.Reputation.ReputationCap)
+	}
+	if len(cfg.Admins) != 2 || cfg.Admins[0] != "a" || cfg.Admins[1] != "z" {
+		t.Fatalf("admins %v, want sorted", cfg.Admins)
+	}
+	if cfg.RequiredRoles[ActionPropose] != RoleMember || cfg.DescriptionRules != DefaultDescriptionRules {
+		t.Fatalf("defaults not reported: %+v", cfg)
+	}
+	// The report is a copy
+	cfg.RequiredRoles[ActionPropose] = RoleElder
+	if dao.Config().RequiredRoles[ActionPropose] != RoleMember {
+		t.Fatal("editing the report changed the contract")
+	}
+}
@@ -0,0 +1,83 @@
+package reputation
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// MarkConflicting declares the given active proposals mutually exclusive.
+// Conflicts are symmetric, so each proposal lists every other in the group.
+func (d *DAOContract) MarkConflicting(ids ...string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("MarkConflicting", ids)
+	group := make([]*Proposal, 0, len(ids))
+	for _, id := range ids {
+		prop, exists := d.lookup(id)
+		if !exists || !prop.Active {
+			return false
+		}
+		group = append(group, prop)
+	}
+	for _, prop := range group {
+		for _, other := range group {
+			if other != prop && !slices.Contains(prop.ConflictsWith, other.ID) {
+				prop.ConflictsWith = append(prop.ConflictsWith, other.ID)
+			}
+		}
+		sort.Strings(prop.ConflictsWith)
+	}
+	return true
+}
+
+// conflictBlocker refuses prop if a proposal it conflicts with has already
+// passed.
+func (d *DAOContract) conflictBlocker(prop *Proposal) error {
+	for _, id := range prop.ConflictsWith {
+		if other, exists := d.proposals[id]; exists && other.Status == StatusPassed {
+			return fmt.Errorf("%w: %q already passed", ErrConflictEnacted, id)
+		}
+	}
+	return nil
+}
+
+// EnactBest enacts, among a conflict group, only the passing proposal with the
+// widest for-minus-against margin (ties go to the lowest ID) and rejects the
+// active members that conflict with it; the rest are left to be decided on
+// their own. It returns the enacted ID, or false if none passed.
+func (d *DAOContract) EnactBest(ids []string) (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("EnactBest", ids)
+	var best *Proposal
+	bestMargin := 0.0
+	for _, id := range ids {
+		prop, exists := d.lookup(id)
+		if !exists || !prop.Active {
+			continue
+		}
+		results := d.tally(prop)
+		if !results.Passes {
+			continue
+		}
+		margin := results.VotesFor - results.VotesAgainst
+		if best == nil || margin > bestMargin || (margin == bestMargin && prop.ID < best.ID) {
+			best, bestMargin = prop, margin
+		}
+	}
+	if best == nil || d.enact(best, d.clock.Now()) != nil {
+		return "", false
+	}
+	for _, id := range ids {
+		if prop, exists := d.lookup(id); exists && prop.Active && slices.Contains(best.ConflictsWith, prop.ID) {
+			prop.setStatus(StatusRejected)
+			d.emitOutcome(prop, Event{
+				Type:    EventProposalSuperseded,
+				Subject: prop.ID,
+				Details: map[string]string{"winner": best.ID},
+			})
+		}
+	}
+	return best.ID, true
+}
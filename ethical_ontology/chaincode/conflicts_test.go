@@ -0,0 +1,53 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func conflictDAO(t *testing.T) *DAOContract {
+	t.Helper()
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 81, "h": 81})
+	for _, id := range []string{"a", "b", "c"} {
+		if !dao.ProposeRule(id, id+" valid description", "f") {
+			t.Fatalf("ProposeRule(%q) refused", id)
+		}
+	}
+	return dao
+}
+
+func TestEnactBestSupersedesOnlyConflicts(t *testing.T) {
+	dao := conflictDAO(t)
+	dao.MarkConflicting("a", "b")
+	dao.Vote("a", "f", true, 1)
+	dao.Vote("a", "g", true, 1)
+	dao.Vote("b", "f", true, 1)
+	id, ok := dao.EnactBest([]string{"a", "b", "c"})
+	if !ok || id != "a" {
+		t.Fatalf("EnactBest = %q, %v; want a", id, ok)
+	}
+	if status := dao.GetProposal("b").Status; status != StatusRejected {
+		t.Fatalf("conflicting loser is %v, want rejected", status)
+	}
+	if prop := dao.GetProposal("c"); !prop.Active || prop.Status != StatusActive {
+		t.Fatalf("unrelated proposal was closed: %v", prop.Status)
+	}
+}
+
+func TestWouldPassNowSharesConflictGuard(t *testing.T) {
+	dao := conflictDAO(t)
+	dao.MarkConflicting("a", "c")
+	dao.Vote("a", "f", true, 1)
+	dao.Vote("a", "g", true, 1)
+	if !dao.Enact("a") {
+		t.Fatal("Enact(a) refused")
+	}
+	dao.Vote("c", "f", true, 1)
+	dao.Vote("c", "g", true, 1)
+	if pass, reason := dao.WouldPassNow("c"); pass {
+		t.Fatalf("WouldPassNow passed a proposal whose conflict was enacted: %s", reason)
+	}
+	if err := dao.EnactChecked("c"); !errors.Is(err, ErrConflictEnacted) {
+		t.Fatalf("EnactChecked: got %v, want ErrConflictEnacted", err)
+	}
+}
@@ -1,90 +1,871 @@
 package reputation
 
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+type VoteChoice int
+
+const (
+	VoteFor VoteChoice = iota
+	VoteAgainst
+	VoteAbstain
+)
+
+func (v VoteChoice) String() string {
+	switch v {
+	case VoteFor:
+		return "for"
+	case VoteAgainst:
+		return "against"
+	case VoteAbstain:
+		return "abstain"
+	default:
+		return "unknown"
+	}
+}
+
+// Ballot is the raw record of a single vote; tallies are derived from it.
+type Ballot struct {
+	ProposalID string
+	Choice     VoteChoice
+	Weight     int
+	Reputation int // reputation snapshot (including delegated power) at vote time
+	Time       int
+	Reason     string // optional justification given by the voter
+}
+
 type Proposal struct {
-	ID           string
-	Description  string
+	ID          string // unique across all categories
+	Category    string
+	Description string
+	ProposerID  string
+	CreatedAt   int
+	Sequence    int      // creation order, starting at 1
+	History     []string // earlier descriptions, oldest first
+	// Tallies are kept as exact fixed-point sums (see WeightScale); the
+	// Votes* floats mirror them for existing callers and are never summed.
+	ForWeight     int64
+	AgainstWeight int64
+	AbstainWeight int64
+	VotesFor      float64
+	VotesAgainst  float64
+	VotesAbstain  float64
+	Voters        map[string]bool // To prevent double voting
+	Ballots       map[string]Ballot
+	Status        ProposalStatus
+	Active        bool // mirrors Status == StatusActive || Status == StatusTallying
+	Paused        bool // voting halted by the circuit breaker
+	ResumedAt     int  // when the breaker pause was last lifted
+	Deadline      int  // zero means no deadline
+	EnactedAt     int
+	Challenges    []Challenge
+	PolicyName    string // registered enactment policy; empty uses the contract default
+	FastTrack     bool
+	ConflictsWith []string // mutually exclusive proposals; at most one may pass
+	Tags          []string // normalized topic labels, sorted
+	Cosigners     []string // sponsors of a multisig proposal, sorted
+	Effects       []Effect // applied in order when the proposal is enacted
+	// Electorate lists, sorted, the only agents who may vote when the
+	// proposal was created with ElectorateFrozen set.
+	Electorate       []string
+	ElectorateFrozen bool
+	// ClosedTally holds the results frozen by CloseVoting while tallying.
+	ClosedTally *ProposalResults
+	// FlaggedForReview marks a likely-spam proposal for moderators; it does
+	// not affect voting.
+	FlaggedForReview bool
+	// AdaptiveTurnout is the minimum turnout fixed by the adaptive quorum
+	// curve at creation. The contract's MinTurnout applies when higher.
+	AdaptiveTurnout float64
+	// EligibleReputation is the reputation of eligible agents at creation.
+	EligibleReputation int
+	ChallengeTarget    string                // agent whose reputation this proposal disputes
+	Stake              int                   // target's reputation in escrow until decided
+	Commitments        map[string]Commitment // unrevealed commit-reveal votes
+}
+
+// ReputationSource is everything DAOContract needs from a reputation
+// ledger. *ReputationContract implements it; tests and ledger-backed
+// integrations can supply their own.
+type ReputationSource interface {
+	GetReputation(agentID string) int
+	TotalReputation() int
+	RoleFor(agentID string) Role
+	Agents() []string
+	IterateByReputation(desc bool, fn func(agentID string, rep int) bool)
+	EligibleElectorate(tokenHoldersOnly bool) (members int, reputation int)
+	HasToken(agentID string) bool
+	PrimaryDomain(agentID string) string
+	// Reward and Slash adjust agentID's score and return the new score,
+	// not the amount applied.
+	Reward(agentID string, amount int) int
+	Slash(agentID string, amount int, reason string) int
+	IsQuarantined(agentID string) bool
+	LockStake(agentID string, amount int) bool
+	SettleStake(agentID string, amount int, forfeit bool)
+}
+
+type DAOContract struct {
+	eventLog
+	mu            sync.RWMutex
+	ops           *opLog
+	proposals     map[string]*Proposal
+	reputation    ReputationSource
+	quorum        float64
+	delegations   map[string]string             // delegator -> delegate
+	splits        map[string]map[string]float64 // delegator -> delegate -> fraction
+	delegationCap DelegationCap
+	minDelegation int // reputation a delegator must hold
+
+	// Abstentions always count toward turnout when abstainCountsTowardQuorum
+	// is set; they only dilute approval when abstainInApprovalDenominator is.
+	abstainCountsTowardQuorum    bool
+	abstainInApprovalDenominator bool
+	minTurnout                   float64
+	minReputationShare           float64
+	minVoters                    int
+	againstMultiplier            float64 // amplifies against-votes when judging a proposal
+	recencyWeight                RecencyWeight
+	quorumCurve                  QuorumCurve
+	authorReward                 int
+	winningSideReward            int
+	revealWindow                 int // seconds after the deadline during which commitments may be revealed
+	commitDeposit                int // reputation escrowed per commitment until it is revealed
+	amendVoteLimit               int // amendments allowed while fewer votes than this are cast
+	selfVoteDisallowed           bool
+	freezeElectorate             bool
+	sponsorRules                 SponsorRules
+	electorate                   QuorumElectorate
+	spamRules                    SpamRules
+	tiePolicy                    TiePolicy
+	tieExtension                 int // seconds TieExtends adds
+	requiredRoles                map[string]Role
+	descriptionRules             DescriptionRules
+	idRules                      IDRules
+	limits                       ProposalLimits
+	lastProposed                 map[string]int // proposerID -> time of their latest proposal
+	votingPeriod                 int
+	challengeRules               ChallengeRules
+	watchers                     map[string]map[int]chan ProposalResults
+	policy                       EnactmentPolicy
+	policies                     map[string]EnactmentPolicy
+	fastTrack                    FastTrackRules
+	maxWeightPerEpoch            int64 // fixed-point, see WeightScale
+	epochLength                  int
+	epochUsage                   map[string]epochUsage
+	nextWatcherID                int
+	proposalCount                int
+	ballotsByAgent               map[string][]string // agentID -> proposals voted on
+
+	clock    Clock
+	logger   Logger
+	notifier NotificationSink
+	admins   map[string]bool
+	breaker  BreakerConfig
+	newHash  func() hash.Hash
+}
+
+// ProposalResults summarises a proposal's tally as Enact would judge it.
+type ProposalResults struct {
+	ProposalID   string
 	VotesFor     float64
 	VotesAgainst float64
-	Voters       map[string]bool // To prevent double voting
-	Active       bool
+	VotesAbstain float64
+	Turnout      float64
+	Approval     float64
+	Voters       int
+	// ReputationShare is the reputation behind turnout-counting ballots as a
+	// fraction of the proposal's EligibleReputation snapshot.
+	ReputationShare float64
+	Active          bool
+	TurnoutMet      bool // turnout and voter minimums satisfied
+	Tied            bool // for and against weigh exactly the same
+	Passes          bool
+	Reason          string
 }
 
-type DAOContract struct {
-	proposals  map[string]*Proposal
-	reputation *ReputationContract
-	quorum     float64
+func NewDAOContract(repContract ReputationSource, quorum float64) *DAOContract {
+	d := &DAOContract{
+		proposals:   make(map[string]*Proposal),
+		reputation:  repContract,
+		quorum:      quorum,
+		delegations: make(map[string]string),
+		splits:      make(map[string]map[string]float64),
+		// By default wording is frozen once the first vote is cast
+		amendVoteLimit:    1,
+		requiredRoles:     map[string]Role{ActionPropose: RoleMember, ActionChallenge: RoleMember},
+		descriptionRules:  DefaultDescriptionRules,
+		againstMultiplier: 1.0,
+		clock:             systemClock{},
+		logger:            nopLogger{},
+		newHash:           sha256.New,
+		admins:            make(map[string]bool),
+		lastProposed:      make(map[string]int),
+		watchers:          make(map[string]map[int]chan ProposalResults),
+		policy:            SimpleMajorityPolicy{},
+		policies:          make(map[string]EnactmentPolicy),
+		fastTrack:         DefaultFastTrackRules,
+		epochUsage:        make(map[string]epochUsage),
+		idRules:           DefaultIDRules,
+		spamRules:         DefaultSpamRules,
+		ballotsByAgent:    make(map[string][]string),
+	}
+	d.ops = &opLog{}
+	if ledger, ok := repContract.(*ReputationContract); ok {
+		d.reputation = daoLedger{ledger}
+		d.ops = ledger.ops
+	}
+	// Recorded at time zero: no clock has been injected yet
+	d.ops.add("dao", "New", 0, []any{quorum})
+	return d
 }
 
-func NewDAOContract(repContract *ReputationContract, quorum float64) *DAOContract {
-	return &DAOContract{
-		proposals:  make(map[string]*Proposal),
-		reputation: repContract,
-		quorum:     quorum,
+func (d *DAOContract) SetClock(clock Clock) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.clock = clock
+}
+
+func (d *DAOContract) AddAdmin(agentID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("AddAdmin", agentID)
+	d.admins[agentID] = true
+}
+
+func (d *DAOContract) SetAbstainPolicy(countsTowardQuorum bool, inApprovalDenominator bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetAbstainPolicy", countsTowardQuorum, inApprovalDenominator)
+	d.abstainCountsTowardQuorum = countsTowardQuorum
+	d.abstainInApprovalDenominator = inApprovalDenominator
+}
+
+// SetMinTurnout sets the vote weight a proposal needs before it can pass.
+// Zero keeps the default of requiring any nonzero turnout.
+func (d *DAOContract) SetMinTurnout(weight float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetMinTurnout", weight)
+	d.minTurnout = weight
+}
+
+// SetMinReputationShare requires the reputation taking part in a vote to
+// reach share (0-1) of the eligible reputation captured when the proposal
+// was created, so a shrinking electorate can't make quorum trivial.
+func (d *DAOContract) SetMinReputationShare(share float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetMinReputationShare", share)
+	d.minReputationShare = share
+}
+
+// participatingReputation sums the reputation behind ballots that count
+// toward turnout.
+func (d *DAOContract) participatingReputation(prop *Proposal) int {
+	total := 0
+	for agentID, ballot := range prop.Ballots {
+		if (ballot.Choice != VoteAbstain || d.abstainCountsTowardQuorum) && d.inElectorate(agentID) {
+			total += ballot.Reputation
+		}
 	}
+	return total
+}
+
+// SetAgainstMultiplier makes blocking cheaper than passing by scaling the
+// against tally; 1.0 treats both sides equally.
+func (d *DAOContract) SetAgainstMultiplier(multiplier float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetAgainstMultiplier", multiplier)
+	d.againstMultiplier = multiplier
+}
+
+// SetMinVoters sets how many distinct voters a proposal needs to pass.
+func (d *DAOContract) SetMinVoters(count int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetMinVoters", count)
+	d.minVoters = count
 }
 
 func (d *DAOContract) ProposeRule(id string, description string, proposerID string) bool {
+	return d.ProposeRuleChecked(id, description, proposerID) == nil
+}
+
+// ProposeRuleChecked is ProposeRule reporting why a proposal was refused.
+func (d *DAOContract) ProposeRuleChecked(id string, description string, proposerID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("ProposeRuleChecked", id, description, proposerID)
+	_, err := d.propose(id, "", description, proposerID)
+	return err
+}
+
+// ProposeRuleInCategory files a proposal under category. IDs are global:
+// categories group proposals but do not namespace them, so an ID can only
+// be used once across every category and GetProposal, Vote and Enact need
+// only the ID.
+func (d *DAOContract) ProposeRuleInCategory(id string, category string, description string, proposerID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("ProposeRuleInCategory", id, category, description, proposerID)
+	_, err := d.propose(id, category, description, proposerID)
+	return err
+}
+
+// ProposeRuleIdempotent derives the proposal ID from the content and proposer
+// so a retried call finds the existing proposal instead of creating another.
+func (d *DAOContract) ProposeRuleIdempotent(description string, proposerID string) (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("ProposeRuleIdempotent", description, proposerID)
+	id := d.contentID(description, proposerID)
 	if _, exists := d.proposals[id]; exists {
+		return id, false
+	}
+	if _, err := d.propose(id, "", description, proposerID); err != nil {
+		return "", false
+	}
+	return id, true
+}
+
+// propose creates a proposal under the normalized form of id and returns it.
+func (d *DAOContract) propose(id string, category string, description string, proposerID string) (*Proposal, error) {
+	id, err := d.idRules.normalize(id)
+	if err != nil {
+		return nil, err
+	}
+	if existing, exists := d.proposals[id]; exists {
+		return nil, fmt.Errorf("%w: %q is already used in category %q", ErrProposalExists, id, existing.Category)
+	}
+	if err := d.descriptionRules.validate(description); err != nil {
+		return nil, err
+	}
+	now := d.clock.Now()
+	if err := d.canPropose(proposerID, now); err != nil {
+		return nil, err
+	}
+	d.lastProposed[proposerID] = now
+	d.proposalCount++
+	deadline := 0
+	if d.votingPeriod > 0 {
+		deadline = now + d.votingPeriod
+	}
+	// Eligibility is judged at creation, so agents dropping out later cannot
+	// shrink the electorate a proposal is measured against
+	members, eligible := d.reputation.EligibleElectorate(d.electorate == TokenHoldersOnly)
+	prop := &Proposal{
+		ID:                 id,
+		Category:           category,
+		Description:        description,
+		ProposerID:         proposerID,
+		CreatedAt:          now,
+		Sequence:           d.proposalCount,
+		VotesFor:           0,
+		VotesAgainst:       0,
+		Voters:             make(map[string]bool),
+		Ballots:            make(map[string]Ballot),
+		Status:             StatusActive,
+		Active:             true,
+		Deadline:           deadline,
+		EligibleReputation: eligible,
+	}
+	if d.freezeElectorate {
+		prop.Electorate, prop.ElectorateFrozen = d.eligibleVoters(), true
+	}
+	d.proposals[id] = prop
+	if d.quorumCurve != nil {
+		prop.AdaptiveTurnout = d.quorumCurve(members, eligible)
+	}
+	d.flagIfSpam(prop)
+	d.logger.Info("proposal_created", "proposal", id, "category", category, "proposer", proposerID, "deadline", deadline)
+	return prop, nil
+}
+
+func (d *DAOContract) SetAmendVoteLimit(limit int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetAmendVoteLimit", limit)
+	d.amendVoteLimit = limit
+}
+
+// AmendProposal lets the original proposer reword an active proposal while
+// few enough votes have been cast, keeping the previous text in History.
+func (d *DAOContract) AmendProposal(proposalID string, proposerID string, newDescription string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("AmendProposal", proposalID, proposerID, newDescription)
+	prop, exists := d.lookup(proposalID)
+	if !exists || prop.Status != StatusActive || prop.ProposerID != proposerID {
 		return false
 	}
-	// Check proposer reputation
-	if d.reputation.GetReputation(proposerID) < 30 {
+	if len(prop.Voters) >= d.amendVoteLimit {
 		return false
 	}
-	d.proposals[id] = &Proposal{
-		ID:           id,
-		Description:  description,
-		VotesFor:     0,
-		VotesAgainst: 0,
-		Voters:       make(map[string]bool),
-		Active:       true,
+	if d.descriptionRules.validate(newDescription) != nil {
+		return false
 	}
+	prop.History = append(prop.History, prop.Description)
+	prop.Description = newDescription
 	return true
 }
 
 func (d *DAOContract) Vote(proposalID string, agentID string, voteFor bool, weight int) bool {
-	prop, exists := d.proposals[proposalID]
-	if !exists || !prop.Active {
-		return false
+	choice := VoteAgainst
+	if voteFor {
+		choice = VoteFor
+	}
+	return d.VoteChecked(proposalID, agentID, choice, weight) == nil
+}
+
+func (d *DAOContract) VoteAbstain(proposalID string, agentID string, weight int) bool {
+	return d.VoteChecked(proposalID, agentID, VoteAbstain, weight) == nil
+}
+
+// VoteChecked casts a ballot and reports why it was refused, if it was.
+func (d *DAOContract) VoteChecked(proposalID string, agentID string, choice VoteChoice, weight int) error {
+	return d.VoteWithReason(proposalID, agentID, choice, weight, "")
+}
+
+// VoteWithReason is VoteChecked with a justification recorded on the ballot.
+func (d *DAOContract) VoteWithReason(proposalID string, agentID string, choice VoteChoice, weight int, reason string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("VoteWithReason", proposalID, agentID, choice, weight, reason)
+	prop, err := d.activeProposal(proposalID)
+	if err != nil {
+		return err
+	}
+	now := d.clock.Now()
+	if err := d.voteBlocker(prop, agentID, now); err != nil {
+		return err
+	}
+	return d.castVote(prop, agentID, choice, weight, reason, now)
+}
+
+// castVote records a ballot from an agent already cleared to vote.
+func (d *DAOContract) castVote(prop *Proposal, agentID string, choice VoteChoice, weight int, reason string, now int) error {
+	ballot := Ballot{
+		ProposalID: prop.ID,
+		Choice:     choice,
+		Weight:     weight,
+		Reputation: d.ownReputation(agentID) + d.delegatedPower(agentID, prop),
+		Time:       now,
+		Reason:     reason,
+	}
+	voteWeight, err := checkedQuadraticWeight(weight, ballot.Reputation)
+	if err != nil {
+		return err
+	}
+	if !weightFits(prop.tallyWeight(choice), voteWeight) {
+		return ErrWeightOverflow
+	}
+	if d.tripBreaker(prop, now) {
+		return ErrVotingPaused
+	}
+	if !d.consumeEpochWeight(agentID, voteWeight, now) {
+		return ErrEpochWeightExceeded
+	}
+	prop.Ballots[agentID] = ballot
+	prop.Voters[agentID] = true
+	d.ballotsByAgent[agentID] = append(d.ballotsByAgent[agentID], prop.ID)
+	prop.addToTally(ballot)
+	d.notifyWatchers(prop)
+	d.logger.Info("vote_cast", "proposal", prop.ID, "agent", agentID, "choice", choice, "weight", weight, "reputation", ballot.Reputation)
+	return nil
+}
+
+// voteBlocker reports why agentID may not vote on prop at now, if anything.
+func (d *DAOContract) voteBlocker(prop *Proposal, agentID string, now int) error {
+	if prop.votingClosed(now) {
+		return ErrVotingClosed
+	}
+	// A committed vote can only be cast by revealing it
+	if _, committed := prop.Commitments[agentID]; committed {
+		return ErrAlreadyCommitted
+	}
+	return d.ballotBlocker(prop, agentID)
+}
+
+// ballotBlocker holds the checks that apply to every ballot, whether cast
+// directly or revealed from a commitment.
+func (d *DAOContract) ballotBlocker(prop *Proposal, agentID string) error {
+	if prop.Paused {
+		return ErrVotingPaused
+	}
+	if d.selfVoteDisallowed && agentID == prop.ProposerID {
+		return ErrSelfVote
 	}
 	if prop.Voters[agentID] {
+		return ErrAlreadyVoted
+	}
+	if !prop.inFrozenElectorate(agentID) {
+		return ErrNotInElectorate
+	}
+	if d.reputation.IsQuarantined(agentID) {
+		return ErrQuarantined
+	}
+	// Agents who delegated have handed their power to their delegate
+	if _, delegated := d.delegations[agentID]; delegated {
+		return ErrVoteDelegated
+	}
+	return nil
+}
+
+func (d *DAOContract) activeProposal(proposalID string) (*Proposal, error) {
+	prop, exists := d.lookup(proposalID)
+	if !exists {
+		return nil, ErrProposalNotFound
+	}
+	if !prop.Active {
+		return nil, ErrProposalInactive
+	}
+	return prop, nil
+}
+
+func (p *Proposal) addToTally(b Ballot) {
+	voteWeight := fixedQuadraticWeight(b.Weight, b.Reputation)
+	switch b.Choice {
+	case VoteFor:
+		p.ForWeight += voteWeight
+	case VoteAgainst:
+		p.AgainstWeight += voteWeight
+	case VoteAbstain:
+		p.AbstainWeight += voteWeight
+	}
+	p.syncVotes()
+}
+
+// tallyWeight is the fixed-point weight cast so far for choice.
+func (p *Proposal) tallyWeight(choice VoteChoice) int64 {
+	switch choice {
+	case VoteFor:
+		return p.ForWeight
+	case VoteAgainst:
+		return p.AgainstWeight
+	case VoteAbstain:
+		return p.AbstainWeight
+	}
+	return 0
+}
+
+func (p *Proposal) syncVotes() {
+	p.VotesFor = weightToFloat(p.ForWeight)
+	p.VotesAgainst = weightToFloat(p.AgainstWeight)
+	p.VotesAbstain = weightToFloat(p.AbstainWeight)
+}
+
+// RecomputeTally rebuilds the running totals from the stored ballots, which
+// are the source of truth if the totals ever drift.
+func (d *DAOContract) RecomputeTally(proposalID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("RecomputeTally", proposalID)
+	prop, exists := d.lookup(proposalID)
+	if !exists {
 		return false
 	}
-	voteWeight := d.reputation.QuadraticVote(agentID, weight)
-	if voteFor {
-		prop.VotesFor += voteWeight
-	} else {
-		prop.VotesAgainst += voteWeight
+	prop.ForWeight, prop.AgainstWeight, prop.AbstainWeight = 0, 0, 0
+	for _, ballot := range prop.Ballots {
+		prop.addToTally(ballot)
 	}
-	prop.Voters[agentID] = true
+	d.notifyWatchers(prop)
 	return true
 }
 
+// sortedVoters returns ballot holders in ID order; the remaining float sums
+// (such as recency-weighted turnout) iterate in this order so every node
+// arrives at the same totals.
+func (p *Proposal) sortedVoters() []string {
+	voters := make([]string, 0, len(p.Ballots))
+	for agentID := range p.Ballots {
+		voters = append(voters, agentID)
+	}
+	sort.Strings(voters)
+	return voters
+}
+
 func (d *DAOContract) Enact(proposalID string) bool {
-	prop, exists := d.proposals[proposalID]
-	if !exists || !prop.Active {
-		return false
+	return d.EnactChecked(proposalID) == nil
+}
+
+// EnactChecked enacts a passing proposal, or reports why it could not.
+func (d *DAOContract) EnactChecked(proposalID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("EnactChecked", proposalID)
+	prop, err := d.activeProposal(proposalID)
+	if err != nil {
+		return err
 	}
-	totalVotes := prop.VotesFor + prop.VotesAgainst
-	if totalVotes == 0 {
-		return false
+	return d.enact(prop, d.clock.Now())
+}
+
+func (d *DAOContract) enact(prop *Proposal, now int) error {
+	results, err := d.enactBlocker(prop, now)
+	if errors.Is(err, ErrNotPassing) {
+		closed := prop.votingClosed(now)
+		if closed && d.extendTie(prop, results, now) {
+			return ErrVotingExtended
+		}
+		// A proposal whose failure is already locked in is settled early
+		if decided, _ := d.decidable(prop, results, now); closed || decided {
+			d.finalizeFailed(prop, results)
+		}
+		d.logger.Info("enact_refused", "proposal", prop.ID, "status", prop.Status, "reason", results.Reason)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	d.applyEffects(prop)
+	prop.setStatus(StatusPassed)
+	prop.EnactedAt = now
+	// Update chaincode or ethical rules here
+	d.emitOutcome(prop, Event{Type: EventProposalEnacted, Subject: prop.ID, Details: map[string]string{"reason": results.Reason}})
+	d.logger.Info("proposal_enacted", "proposal", prop.ID, "for", results.VotesFor, "against", results.VotesAgainst, "turnout", results.Turnout)
+	d.rewardAuthor(prop)
+	d.rewardWinningSide(prop, VoteFor)
+	return nil
+}
+
+// SetAuthorReward sets the reputation granted to a proposer when their
+// proposal is enacted; zero disables the reward.
+func (d *DAOContract) SetAuthorReward(amount int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetAuthorReward", amount)
+	d.authorReward = amount
+}
+
+func (d *DAOContract) rewardAuthor(prop *Proposal) {
+	if d.authorReward <= 0 || prop.ProposerID == "" {
+		return
+	}
+	rep := d.reputation.Reward(prop.ProposerID, d.authorReward)
+	d.emit(Event{
+		Type:    EventAuthorRewarded,
+		Subject: prop.ProposerID,
+		Details: map[string]string{
+			"proposal":   prop.ID,
+			"amount":     strconv.Itoa(d.authorReward),
+			"reputation": strconv.Itoa(rep),
+		},
+	})
+}
+
+// SetWinningSideReward grants amount of reputation to every agent whose
+// ballot matched a decided outcome: for on an enacted proposal, against on
+// a rejected one. Zero, the default, disables it.
+func (d *DAOContract) SetWinningSideReward(amount int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetWinningSideReward", amount)
+	d.winningSideReward = amount
+}
+
+func (d *DAOContract) rewardWinningSide(prop *Proposal, winning VoteChoice) {
+	if d.winningSideReward <= 0 {
+		return
+	}
+	for _, agentID := range prop.sortedVoters() {
+		if prop.Ballots[agentID].Choice != winning {
+			continue
+		}
+		rep := d.reputation.Reward(agentID, d.winningSideReward)
+		d.emit(Event{
+			Type:    EventVoterRewarded,
+			Subject: agentID,
+			Details: map[string]string{
+				"proposal":   prop.ID,
+				"amount":     strconv.Itoa(d.winningSideReward),
+				"reputation": strconv.Itoa(rep),
+			},
+		})
+		d.logger.Info("voter_rewarded", "proposal", prop.ID, "agent", agentID, "choice", winning, "reputation", rep)
+	}
+}
+
+func (d *DAOContract) GetProposalResults(proposalID string) (ProposalResults, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	prop, exists := d.lookup(proposalID)
+	if !exists {
+		return ProposalResults{}, false
+	}
+	return d.tally(prop), true
+}
+
+// tally computes a proposal's results and asks its enactment policy for the verdict.
+func (d *DAOContract) tally(prop *Proposal) ProposalResults {
+	if prop.ClosedTally != nil {
+		return d.judge(prop, *prop.ClosedTally)
+	}
+	results := ProposalResults{
+		ProposalID:   prop.ID,
+		VotesFor:     weightToFloat(prop.ForWeight),
+		VotesAgainst: weightToFloat(prop.AgainstWeight) * d.againstMultiplier,
+		VotesAbstain: weightToFloat(prop.AbstainWeight),
+		Active:       prop.Active,
+	}
+	if d.recencyWeight != nil || d.electorate != AllReputation {
+		results.Turnout = d.weightedTurnout(prop)
+	} else {
+		results.Turnout = results.VotesFor + results.VotesAgainst
+		if d.abstainCountsTowardQuorum {
+			results.Turnout += results.VotesAbstain
+		}
 	}
-	if prop.VotesFor/totalVotes >= d.quorum {
-		prop.Active = false
-		// Update chaincode or ethical rules here
-		return true
+	results.Voters = d.quorumVoters(prop)
+	if prop.EligibleReputation > 0 {
+		results.ReputationShare = float64(d.participatingReputation(prop)) / float64(prop.EligibleReputation)
 	}
-	return false
+	return d.judge(prop, results)
 }
 
+// judge derives approval from the totals in results and asks the policy for
+// the verdict.
+func (d *DAOContract) judge(prop *Proposal, results ProposalResults) ProposalResults {
+	denominator := results.VotesFor + results.VotesAgainst
+	if d.abstainInApprovalDenominator {
+		denominator += results.VotesAbstain
+	}
+	results.Approval = 0
+	if denominator > 0 {
+		results.Approval = results.VotesFor / denominator
+	}
+	if !results.finite() {
+		results.Reason = "tally is not finite"
+		return results
+	}
+	outcome, reason := d.policyFor(prop).Evaluate(prop, d.policyContext(prop, results))
+	results.Passes = outcome == OutcomePass
+	results.TurnoutMet = outcome != OutcomeNoQuorum
+	results.Reason = reason
+	return d.breakTie(results)
+}
+
+func (r ProposalResults) finite() bool {
+	for _, v := range []float64{r.VotesFor, r.VotesAgainst, r.VotesAbstain, r.Turnout, r.Approval, r.ReputationShare} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// enactBlocker runs every check enact makes before it changes anything and
+// returns the tally with the first reason prop can't be enacted at now.
+// Enact and WouldPassNow share it so they agree.
+func (d *DAOContract) enactBlocker(prop *Proposal, now int) (ProposalResults, error) {
+	if prop.Status == StatusActive && d.revealPending(prop, now) {
+		return ProposalResults{}, ErrRevealPending
+	}
+	results := d.tally(prop)
+	if !results.finite() {
+		// A corrupted tally must not close the proposal as if it had failed
+		return results, ErrInvalidTally
+	}
+	if !results.Passes {
+		return results, fmt.Errorf("%w: %s", ErrNotPassing, results.Reason)
+	}
+	if err := d.conflictBlocker(prop); err != nil {
+		return results, err
+	}
+	// A passing proposal whose effects no longer validate stays undecided
+	return results, d.validateEffects(prop.Effects)
+}
+
+// WouldPassNow reports whether Enact would succeed if voting ended now, and why.
+func (d *DAOContract) WouldPassNow(proposalID string) (bool, string) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	prop, err := d.activeProposal(proposalID)
+	if err != nil {
+		return false, err.Error()
+	}
+	results, err := d.enactBlocker(prop, d.clock.Now())
+	if errors.Is(err, ErrNotPassing) {
+		return false, results.Reason
+	}
+	if err != nil {
+		return false, err.Error()
+	}
+	return true, results.Reason
+}
+
+// GetProposal returns the live proposal; callers outside the package should
+// prefer SnapshotProposals when reading concurrently with votes.
 func (d *DAOContract) GetProposal(id string) *Proposal {
-	return d.proposals[id]
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	prop, _ := d.lookup(id)
+	return prop
 }
 
+// GetAllProposals returns the live proposals and is meant for package-internal
+// use; see SnapshotProposals.
 func (d *DAOContract) GetAllProposals() []*Proposal {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
 	var all []*Proposal
 	for _, p := range d.proposals {
 		all = append(all, p)
 	}
 	return all
 }
+
+// SnapshotProposals returns deep copies of every proposal, ordered by ID,
+// which are safe to read without holding the contract's lock.
+func (d *DAOContract) SnapshotProposals() []Proposal {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	ids := make([]string, 0, len(d.proposals))
+	for id := range d.proposals {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	snapshot := make([]Proposal, 0, len(ids))
+	for _, id := range ids {
+		snapshot = append(snapshot, d.proposals[id].clone())
+	}
+	return snapshot
+}
+
+func (p *Proposal) clone() Proposal {
+	c := *p
+	c.History = append([]string(nil), p.History...)
+	c.Challenges = append([]Challenge(nil), p.Challenges...)
+	c.ConflictsWith = append([]string(nil), p.ConflictsWith...)
+	c.Tags = append([]string(nil), p.Tags...)
+	c.Cosigners = append([]string(nil), p.Cosigners...)
+	c.Effects = append([]Effect(nil), p.Effects...)
+	c.Electorate = append([]string(nil), p.Electorate...)
+	if p.ClosedTally != nil {
+		frozen := *p.ClosedTally
+		c.ClosedTally = &frozen
+	}
+	c.Commitments = make(map[string]Commitment, len(p.Commitments))
+	for agentID, commitment := range p.Commitments {
+		commitment.Digest = append([]byte(nil), commitment.Digest...)
+		c.Commitments[agentID] = commitment
+	}
+	c.Voters = make(map[string]bool, len(p.Voters))
+	for agentID, voted := range p.Voters {
+		c.Voters[agentID] = voted
+	}
+	c.Ballots = make(map[string]Ballot, len(p.Ballots))
+	for agentID, ballot := range p.Ballots {
+		c.Ballots[agentID] = ballot
+	}
+	return c
+}
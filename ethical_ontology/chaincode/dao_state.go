@@ -0,0 +1,187 @@
+package reputation
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// daoState is the serialized form of a DAOContract. Wiring supplied in code
+// (the reputation source, clock, hasher, logger, notification sink,
+// registered and default policies, recency weighting, quorum curve and
+// watchers) is not state and must be set again after loading.
+type daoState struct {
+	Proposals                    []*Proposal                   `json:"proposals"`
+	ProposalCount                int                           `json:"proposal_count"`
+	Quorum                       float64                       `json:"quorum"`
+	Delegations                  map[string]string             `json:"delegations"`
+	Splits                       map[string]map[string]float64 `json:"splits"`
+	DelegationCap                DelegationCap                 `json:"delegation_cap"`
+	MinDelegation                int                           `json:"min_delegation"`
+	AbstainCountsTowardQuorum    bool                          `json:"abstain_counts_toward_quorum"`
+	AbstainInApprovalDenominator bool                          `json:"abstain_in_approval_denominator"`
+	MinTurnout                   float64                       `json:"min_turnout"`
+	MinReputationShare           float64                       `json:"min_reputation_share"`
+	MinVoters                    int                           `json:"min_voters"`
+	AgainstMultiplier            float64                       `json:"against_multiplier"`
+	AuthorReward                 int                           `json:"author_reward"`
+	WinningSideReward            int                           `json:"winning_side_reward"`
+	RevealWindow                 int                           `json:"reveal_window"`
+	CommitDeposit                int                           `json:"commit_deposit"`
+	AmendVoteLimit               int                           `json:"amend_vote_limit"`
+	SelfVoteDisallowed           bool                          `json:"self_vote_disallowed"`
+	ElectorateFrozen             bool                          `json:"electorate_frozen"`
+	SponsorRules                 SponsorRules                  `json:"sponsor_rules"`
+	QuorumElectorate             QuorumElectorate              `json:"quorum_electorate"`
+	SpamRules                    *SpamRules                    `json:"spam_rules,omitempty"`
+	TiePolicy                    TiePolicy                     `json:"tie_policy"`
+	TieExtension                 int                           `json:"tie_extension"`
+	RequiredRoles                map[string]Role               `json:"required_roles"`
+	DescriptionRules             DescriptionRules              `json:"description_rules"`
+	IDRules                      *IDRules                      `json:"id_rules,omitempty"`
+	Limits                       ProposalLimits                `json:"limits"`
+	LastProposed                 map[string]int                `json:"last_proposed"`
+	VotingPeriod                 int                           `json:"voting_period"`
+	ChallengeRules               ChallengeRules                `json:"challenge_rules"`
+	FastTrack                    FastTrackRules                `json:"fast_track"`
+	MaxWeightPerEpoch            int64                         `json:"max_weight_per_epoch"`
+	EpochLength                  int                           `json:"epoch_length"`
+	EpochUsage                   map[string]epochUsage         `json:"epoch_usage"`
+	Admins                       map[string]bool               `json:"admins"`
+	Breaker                      BreakerConfig                 `json:"breaker"`
+	Events                       []Event                       `json:"events"`
+}
+
+func (d *DAOContract) MarshalJSON() ([]byte, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return json.Marshal(d.state())
+}
+
+// UnmarshalJSON loads state into a contract built with NewDAOContract, which
+// keeps its reputation source and other wiring.
+func (d *DAOContract) UnmarshalJSON(data []byte) error {
+	var state daoState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("UnmarshalJSON", data)
+	d.restore(state)
+	return nil
+}
+
+// state captures the contract for serialization; the caller holds d.mu.
+func (d *DAOContract) state() daoState {
+	proposals := make([]*Proposal, 0, len(d.proposals))
+	for _, prop := range d.proposals {
+		proposals = append(proposals, prop)
+	}
+	sort.Slice(proposals, func(i, j int) bool { return proposals[i].ID < proposals[j].ID })
+	return daoState{
+		Proposals:                    proposals,
+		ProposalCount:                d.proposalCount,
+		Quorum:                       d.quorum,
+		Delegations:                  d.delegations,
+		Splits:                       d.splits,
+		DelegationCap:                d.delegationCap,
+		MinDelegation:                d.minDelegation,
+		AbstainCountsTowardQuorum:    d.abstainCountsTowardQuorum,
+		AbstainInApprovalDenominator: d.abstainInApprovalDenominator,
+		MinTurnout:                   d.minTurnout,
+		MinReputationShare:           d.minReputationShare,
+		MinVoters:                    d.minVoters,
+		AgainstMultiplier:            d.againstMultiplier,
+		AuthorReward:                 d.authorReward,
+		WinningSideReward:            d.winningSideReward,
+		RevealWindow:                 d.revealWindow,
+		CommitDeposit:                d.commitDeposit,
+		AmendVoteLimit:               d.amendVoteLimit,
+		SelfVoteDisallowed:           d.selfVoteDisallowed,
+		ElectorateFrozen:             d.freezeElectorate,
+		SponsorRules:                 d.sponsorRules,
+		QuorumElectorate:             d.electorate,
+		SpamRules:                    &d.spamRules,
+		TiePolicy:                    d.tiePolicy,
+		TieExtension:                 d.tieExtension,
+		RequiredRoles:                d.requiredRoles,
+		DescriptionRules:             d.descriptionRules,
+		IDRules:                      &d.idRules,
+		Limits:                       d.limits,
+		LastProposed:                 d.lastProposed,
+		VotingPeriod:                 d.votingPeriod,
+		ChallengeRules:               d.challengeRules,
+		FastTrack:                    d.fastTrack,
+		MaxWeightPerEpoch:            d.maxWeightPerEpoch,
+		EpochLength:                  d.epochLength,
+		EpochUsage:                   d.epochUsage,
+		Admins:                       d.admins,
+		Breaker:                      d.breaker,
+		Events:                       d.Events(),
+	}
+}
+
+// restore replaces the contract's state with a decoded one; the caller holds
+// d.mu for writing. Derived indexes are rebuilt from the proposals.
+func (d *DAOContract) restore(state daoState) {
+	fresh := NewDAOContract(d.reputation, state.Quorum)
+	d.proposals = make(map[string]*Proposal, len(state.Proposals))
+	d.ballotsByAgent = fresh.ballotsByAgent
+	for _, prop := range state.Proposals {
+		prop.Voters = orEmpty(prop.Voters, make(map[string]bool))
+		prop.Ballots = orEmpty(prop.Ballots, make(map[string]Ballot))
+		prop.setStatus(prop.Status)
+		prop.syncVotes()
+		d.proposals[prop.ID] = prop
+		for agentID := range prop.Ballots {
+			d.ballotsByAgent[agentID] = append(d.ballotsByAgent[agentID], prop.ID)
+		}
+	}
+	d.proposalCount = state.ProposalCount
+	d.quorum = state.Quorum
+	d.delegations = orEmpty(state.Delegations, fresh.delegations)
+	d.splits = orEmpty(state.Splits, fresh.splits)
+	d.delegationCap = state.DelegationCap
+	d.minDelegation = state.MinDelegation
+	d.abstainCountsTowardQuorum = state.AbstainCountsTowardQuorum
+	d.abstainInApprovalDenominator = state.AbstainInApprovalDenominator
+	d.minTurnout = state.MinTurnout
+	d.minReputationShare = state.MinReputationShare
+	d.minVoters = state.MinVoters
+	d.againstMultiplier = state.AgainstMultiplier
+	d.authorReward = state.AuthorReward
+	d.winningSideReward = state.WinningSideReward
+	d.revealWindow = state.RevealWindow
+	d.commitDeposit = state.CommitDeposit
+	d.amendVoteLimit = state.AmendVoteLimit
+	d.selfVoteDisallowed = state.SelfVoteDisallowed
+	d.freezeElectorate = state.ElectorateFrozen
+	d.sponsorRules = state.SponsorRules
+	d.electorate = state.QuorumElectorate
+	// Blobs written before these rules existed keep the defaults
+	d.spamRules = fresh.spamRules
+	if state.SpamRules != nil {
+		d.spamRules = *state.SpamRules
+	}
+	d.tiePolicy = state.TiePolicy
+	d.tieExtension = state.TieExtension
+	d.requiredRoles = orEmpty(state.RequiredRoles, fresh.requiredRoles)
+	d.descriptionRules = state.DescriptionRules
+	d.idRules = fresh.idRules
+	if state.IDRules != nil {
+		d.idRules = *state.IDRules
+	}
+	d.limits = state.Limits
+	d.lastProposed = orEmpty(state.LastProposed, fresh.lastProposed)
+	d.votingPeriod = state.VotingPeriod
+	d.challengeRules = state.ChallengeRules
+	d.fastTrack = state.FastTrack
+	d.maxWeightPerEpoch = state.MaxWeightPerEpoch
+	d.epochLength = state.EpochLength
+	d.epochUsage = orEmpty(state.EpochUsage, fresh.epochUsage)
+	d.admins = orEmpty(state.Admins, fresh.admins)
+	d.breaker = state.Breaker
+	d.eventsMu.Lock()
+	d.events = state.Events
+	d.eventsMu.Unlock()
+}
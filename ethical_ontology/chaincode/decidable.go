@@ -0,0 +1,99 @@
+package reputation
+
+import "math"
+
+// IsDecidable reports whether a proposal's outcome is already locked in:
+// whatever could still be cast, it would end as outcome. Decided proposals
+// report their status. An open proposal can only be decided when the weight
+// still to come is bounded, which needs both its electorate frozen at
+// creation (SetElectorateFrozen) and an epoch weight cap on every ballot.
+// Later rule changes are not anticipated, and recency weighting, pending
+// reveals and ties that would extend voting keep a proposal undecidable
+// until it closes.
+func (d *DAOContract) IsDecidable(proposalID string) (bool, ProposalStatus) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	prop, exists := d.lookup(proposalID)
+	if !exists {
+		return false, StatusActive
+	}
+	if prop.Status == StatusAwaitingSponsors {
+		return false, prop.Status
+	}
+	if !prop.Active {
+		return true, prop.Status
+	}
+	return d.decidable(prop, d.tally(prop), d.clock.Now())
+}
+
+// decidable is IsDecidable for an active proposal whose current tally is
+// results. A vote outcome of StatusPassed may still be refused by Enact for
+// a conflict or failing effects.
+func (d *DAOContract) decidable(prop *Proposal, results ProposalResults, now int) (bool, ProposalStatus) {
+	if d.revealPending(prop, now) || !results.finite() {
+		return false, prop.Status
+	}
+	low, high := results, results
+	spread := 0.0
+	if !prop.votingClosed(now) {
+		remaining, bound, bounded := d.uncastWeight(prop)
+		if !bounded || d.recencyWeight != nil {
+			return false, prop.Status
+		}
+		if spread = bound; spread > 0 {
+			low, high = d.tallyRange(prop, results, remaining, spread)
+		}
+	}
+	// A failing proposal that is or could end tied may yet be extended
+	if !results.Passes && d.tiePolicy == TieExtends && d.tieExtension > 0 && (results.Tied || spread > 0) {
+		return false, prop.Status
+	}
+	switch {
+	case results.Passes && low.Passes:
+		return true, StatusPassed
+	case results.Passes || high.Passes:
+		return false, prop.Status
+	// Failure is certain; whether as a rejection or an expiry depends on turnout
+	case results.TurnoutMet && low.TurnoutMet:
+		return true, StatusRejected
+	case !results.TurnoutMet && !high.TurnoutMet:
+		return true, StatusExpired
+	}
+	return false, prop.Status
+}
+
+// uncastWeight bounds what may still be cast on prop: how many of its
+// frozen electorate haven't voted and the most weight they could carry
+// between them on either side. Reputation can grow after creation, so only
+// the epoch cap bounds a single ballot's weight.
+func (d *DAOContract) uncastWeight(prop *Proposal) (remaining int, spread float64, bounded bool) {
+	if !prop.ElectorateFrozen || !d.epochCapped() {
+		return 0, 0, false
+	}
+	for _, agentID := range prop.Electorate {
+		if !prop.Voters[agentID] {
+			remaining++
+		}
+	}
+	return remaining, weightToFloat(d.maxWeightPerEpoch) * float64(remaining), true
+}
+
+// tallyRange judges the least and most favourable tallies spread more
+// weight could produce from results: all of it against, or all of it for
+// with every remaining voter turning out. It relies on ballot weights being
+// non-negative, which castVote enforces by refusing weights below one.
+// Treating the shifts as independent overstates the range, which only makes
+// IsDecidable more cautious.
+func (d *DAOContract) tallyRange(prop *Proposal, results ProposalResults, remaining int, spread float64) (low, high ProposalResults) {
+	low, high = results, results
+	low.VotesAgainst += spread * d.againstMultiplier
+	high.VotesFor += spread
+	high.Turnout += spread * max(1, d.againstMultiplier)
+	if d.abstainInApprovalDenominator {
+		low.VotesAbstain += spread
+	}
+	high.Voters += remaining
+	// Reputation may have grown since the snapshot, so any share is reachable
+	high.ReputationShare = math.MaxFloat64
+	return d.judge(prop, low), d.judge(prop, high)
+}
@@ -0,0 +1,80 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsDecidableNeedsBoundedWeight(t *testing.T) {
+	dao, rep, clock := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90, "c": 90, "d": 90})
+	dao.SetVotingPeriod(1000)
+	clock.now = 100
+	dao.ProposeRule("p", "a valid description", "a")
+	for _, agentID := range []string{"a", "b", "c", "d"} {
+		dao.Vote("p", agentID, false, 1)
+	}
+	clock.now = 101
+	if decided, _ := dao.IsDecidable("p"); decided {
+		t.Fatal("an open electorate with unbounded weight must stay undecidable")
+	}
+	if err := dao.EnactChecked("p"); !errors.Is(err, ErrNotPassing) {
+		t.Fatalf("Enact: got %v, want ErrNotPassing", err)
+	}
+	if status := dao.GetProposal("p").Status; status != StatusActive {
+		t.Fatalf("proposal settled early as %s", status)
+	}
+	rep.MintToken("e", 95)
+	if err := dao.VoteChecked("p", "e", VoteFor, 1); err != nil {
+		t.Fatalf("late member vote: %v", err)
+	}
+}
+
+func TestHeavyAgainstRejectsEarly(t *testing.T) {
+	dao, rep, clock := newTestDAO(t, 0.5, map[string]int{"a": 100, "b": 90, "c": 85})
+	dao.SetVotingPeriod(1000)
+	dao.SetElectorateFrozen(true)
+	dao.SetEpochWeightCap(40, 10000)
+	dao.SetAgainstMultiplier(100)
+	dao.ProposeRule("p", "a valid description", "a")
+	if decided, _ := dao.IsDecidable("p"); decided {
+		t.Fatal("a proposal nobody has voted on is undecided")
+	}
+	if err := dao.VoteChecked("p", "b", VoteAgainst, 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := dao.VoteChecked("p", "a", VoteAgainst, 3); err != nil {
+		t.Fatal(err)
+	}
+	// c can add at most 40 for; against is 100 * (4*sqrt(90) + 3*sqrt(100))
+	decided, outcome := dao.IsDecidable("p")
+	if !decided || outcome != StatusRejected {
+		t.Fatalf("IsDecidable = %v, %s; want true, rejected", decided, outcome)
+	}
+	rep.MintToken("late", 95)
+	if err := dao.VoteChecked("p", "late", VoteFor, 1); !errors.Is(err, ErrNotInElectorate) {
+		t.Fatalf("vote from outside the frozen electorate: got %v", err)
+	}
+	clock.now = 10
+	if err := dao.EnactChecked("p"); !errors.Is(err, ErrNotPassing) {
+		t.Fatalf("Enact: got %v, want ErrNotPassing", err)
+	}
+	if status := dao.GetProposal("p").Status; status != StatusRejected {
+		t.Fatalf("status %s, want rejected before the deadline", status)
+	}
+}
+
+func TestIsDecidableLockedPass(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 100, "b": 90, "c": 85})
+	dao.SetElectorateFrozen(true)
+	dao.SetEpochWeightCap(40, 10000)
+	dao.ProposeRule("p", "a valid description", "a")
+	for _, agentID := range []string{"a", "b", "c"} {
+		dao.Vote("p", agentID, true, 1)
+	}
+	if decided, outcome := dao.IsDecidable("p"); !decided || outcome != StatusPassed {
+		t.Fatalf("IsDecidable = %v, %s; want true, passed", decided, outcome)
+	}
+	if decided, _ := dao.IsDecidable("missing"); decided {
+		t.Fatal("unknown proposal reported decided")
+	}
+}
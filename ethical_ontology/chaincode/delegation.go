@@ -0,0 +1,201 @@
+package reputation
+
+// DelegationCap bounds the total delegated reputation a single delegate may
+// wield. Absolute is a reputation amount and Fraction a share of total
+// reputation; zero disables a bound, and if both are set the lower applies.
+type DelegationCap struct {
+	Absolute int
+	Fraction float64
+}
+
+func (d *DAOContract) SetDelegationCap(limit DelegationCap) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetDelegationCap", limit)
+	d.delegationCap = limit
+}
+
+// SetMinDelegationReputation requires delegators to hold at least minimum
+// reputation; zero lets anyone delegate.
+func (d *DAOContract) SetMinDelegationReputation(minimum int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetMinDelegationReputation", minimum)
+	d.minDelegation = minimum
+}
+
+// Delegate hands fromAgentID's voting power to toAgentID. Delegations are
+// transitive, so the power ends up with the last agent in the chain.
+func (d *DAOContract) Delegate(fromAgentID string, toAgentID string) bool {
+	return d.DelegateChecked(fromAgentID, toAgentID) == nil
+}
+
+// DelegateChecked is Delegate reporting why a delegation was refused.
+func (d *DAOContract) DelegateChecked(fromAgentID string, toAgentID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("DelegateChecked", fromAgentID, toAgentID)
+	// Checked before cycle detection so resolution never sees a self-loop
+	if fromAgentID == toAgentID {
+		return ErrSelfDelegation
+	}
+	if d.reputation.IsQuarantined(fromAgentID) || d.reputation.IsQuarantined(toAgentID) {
+		return ErrQuarantined
+	}
+	if d.reputation.GetReputation(fromAgentID) < d.minDelegation {
+		return ErrDelegatorReputation
+	}
+	// Reject cycles: walking from the target must never reach the delegator
+	for cur := toAgentID; cur != ""; cur = d.delegations[cur] {
+		if cur == fromAgentID {
+			return ErrDelegationCycle
+		}
+	}
+	if d.delegationInUse(fromAgentID) {
+		return ErrDelegationInUse
+	}
+	previous, hadPrevious := d.delegations[fromAgentID]
+	delete(d.delegations, fromAgentID)
+
+	final := d.resolveDelegate(toAgentID)
+	incoming := d.reputation.GetReputation(fromAgentID) + d.delegatedPower(fromAgentID, nil)
+	if limit, capped := d.delegationLimit(); capped && d.delegatedPower(final, nil)+incoming > limit {
+		if hadPrevious {
+			d.delegations[fromAgentID] = previous
+		}
+		return ErrDelegationCap
+	}
+	d.delegations[fromAgentID] = toAgentID
+	delete(d.splits, fromAgentID)
+	d.logger.Info("delegated", "from", fromAgentID, "to", toAgentID, "resolved", final)
+	return nil
+}
+
+func (d *DAOContract) GetDelegate(agentID string) string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.delegations[agentID]
+}
+
+// resolveDelegate follows the delegation chain to the agent who actually votes.
+func (d *DAOContract) resolveDelegate(agentID string) string {
+	for {
+		next, ok := d.delegations[agentID]
+		if !ok {
+			return agentID
+		}
+		agentID = next
+	}
+}
+
+// delegationInUse reports whether moving fromAgentID's delegated power could
+// count it twice: one of their current delegates has voted on an open
+// proposal that fromAgentID hasn't voted on directly, or fromAgentID's own
+// open ballot was cast with power delegated to them. Either ballot may
+// already carry the power. Ballots keep the power they were cast with, so
+// the delegation stays put until those proposals are decided.
+func (d *DAOContract) delegationInUse(fromAgentID string) bool {
+	for _, id := range d.ballotsByAgent[fromAgentID] {
+		if prop := d.proposals[id]; prop.Active && d.delegatedPower(fromAgentID, prop) > 0 {
+			return true
+		}
+	}
+	var targets []string
+	if to, delegated := d.delegations[fromAgentID]; delegated {
+		targets = append(targets, to)
+	}
+	for to := range d.splits[fromAgentID] {
+		targets = append(targets, to)
+	}
+	for _, to := range targets {
+		for _, id := range d.ballotsByAgent[d.resolveDelegate(to)] {
+			if prop := d.proposals[id]; prop.Active && !prop.Voters[fromAgentID] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// delegatedPower sums the reputation of every agent whose delegation chain
+// ends at agentID. When prop is given, delegators who already voted on it
+// directly are skipped so their reputation isn't counted twice. Quarantined
+// delegators' power is frozen along with their own vote.
+func (d *DAOContract) delegatedPower(agentID string, prop *Proposal) int {
+	total := 0
+	for delegator := range d.delegations {
+		if (prop != nil && prop.Voters[delegator]) || d.reputation.IsQuarantined(delegator) {
+			continue
+		}
+		if d.resolveDelegate(delegator) == agentID {
+			total += d.reputation.GetReputation(delegator)
+		}
+	}
+	for delegator, splits := range d.splits {
+		if d.reputation.IsQuarantined(delegator) {
+			continue
+		}
+		for target, share := range d.splitShares(delegator, splits) {
+			if d.resolveDelegate(target) == agentID {
+				total += share
+			}
+		}
+	}
+	return total
+}
+
+func (d *DAOContract) delegationLimit() (int, bool) {
+	limit, capped := 0, false
+	if d.delegationCap.Absolute > 0 {
+		limit, capped = d.delegationCap.Absolute, true
+	}
+	if d.delegationCap.Fraction > 0 {
+		share := int(d.delegationCap.Fraction * float64(d.reputation.TotalReputation()))
+		if !capped || share < limit {
+			limit, capped = share, true
+		}
+	}
+	return limit, capped
+}
+
+// DelegationGraph returns a copy of every delegator -> delegate edge.
+func (d *DAOContract) DelegationGraph() map[string]string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	graph := make(map[string]string, len(d.delegations))
+	for from, to := range d.delegations {
+		graph[from] = to
+	}
+	return graph
+}
+
+// DelegationResolvedPower reports the reputation each agent would vote with
+// at now once transitive delegations are resolved. Delegators report zero,
+// so the values sum to the total reputation of the agents involved. Power is
+// currently time-independent; now is the evaluation point for callers.
+func (d *DAOContract) DelegationResolvedPower(now int) map[string]float64 {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	agents := d.reputation.Agents()
+	for from, to := range d.delegations {
+		agents = append(agents, from, to)
+	}
+	for from, splits := range d.splits {
+		agents = append(agents, from)
+		for to := range splits {
+			agents = append(agents, to)
+		}
+	}
+	power := make(map[string]float64, len(agents))
+	for _, agentID := range agents {
+		if _, seen := power[agentID]; seen {
+			continue
+		}
+		if _, delegated := d.delegations[agentID]; delegated {
+			power[agentID] = 0
+			continue
+		}
+		power[agentID] = float64(d.ownReputation(agentID) + d.delegatedPower(agentID, nil))
+	}
+	return power
+}
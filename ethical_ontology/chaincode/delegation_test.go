@@ -0,0 +1,135 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDelegationCapAndCycles(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 85, "c": 95})
+	dao.SetDelegationCap(DelegationCap{Absolute: 100})
+	if err := dao.DelegateChecked("a", "c"); err != nil {
+		t.Fatalf("first delegation: %v", err)
+	}
+	if err := dao.DelegateChecked("b", "c"); !errors.Is(err, ErrDelegationCap) {
+		t.Fatalf("delegation over the cap: got %v, want ErrDelegationCap", err)
+	}
+	if err := dao.DelegateChecked("c", "a"); !errors.Is(err, ErrDelegationCycle) {
+		t.Fatalf("cyclic delegation: got %v, want ErrDelegationCycle", err)
+	}
+	dao.ProposeRule("p", "a valid description", "c")
+	if err := dao.VoteChecked("p", "c", VoteFor, 1); err != nil {
+		t.Fatalf("delegate vote: %v", err)
+	}
+	if err := dao.VoteChecked("p", "a", VoteFor, 1); !errors.Is(err, ErrVoteDelegated) {
+		t.Fatalf("delegator vote: got %v, want ErrVoteDelegated", err)
+	}
+}
+
+func TestRedelegationCannotCountPowerTwice(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90, "c": 90})
+	dao.ProposeRule("p", "a valid description", "b")
+	dao.Delegate("a", "b")
+	if err := dao.VoteChecked("p", "b", VoteFor, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got := dao.GetProposal("p").Ballots["b"].Reputation; got != 180 {
+		t.Fatalf("delegate ballot carries %d, want 180", got)
+	}
+	if err := dao.DelegateChecked("a", "c"); !errors.Is(err, ErrDelegationInUse) {
+		t.Fatalf("re-delegation after the delegate voted: got %v, want ErrDelegationInUse", err)
+	}
+	if err := dao.DelegateSplitChecked("a", map[string]float64{"c": 0.5}); !errors.Is(err, ErrDelegationInUse) {
+		t.Fatalf("split after the delegate voted: got %v, want ErrDelegationInUse", err)
+	}
+	if err := dao.VoteChecked("p", "c", VoteFor, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got := dao.GetProposal("p").Ballots["c"].Reputation; got != 90 {
+		t.Fatalf("second ballot carries %d, want only c's own 90", got)
+	}
+
+	if !dao.Enact("p") {
+		t.Fatal("p should pass")
+	}
+	if err := dao.DelegateChecked("a", "c"); err != nil {
+		t.Fatalf("re-delegation once p is decided: %v", err)
+	}
+}
+
+func TestSplitRedelegationCannotCountPowerTwice(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90, "c": 90})
+	dao.ProposeRule("p", "a valid description", "b")
+	if err := dao.DelegateSplitChecked("a", map[string]float64{"b": 0.5}); err != nil {
+		t.Fatal(err)
+	}
+	dao.Vote("p", "b", true, 1)
+	if err := dao.DelegateSplitChecked("a", map[string]float64{"c": 0.5}); !errors.Is(err, ErrDelegationInUse) {
+		t.Fatalf("moving a split share after the delegate voted: got %v, want ErrDelegationInUse", err)
+	}
+	if err := dao.DelegateSplitChecked("a", nil); !errors.Is(err, ErrDelegationInUse) {
+		t.Fatalf("withdrawing a split share after the delegate voted: got %v, want ErrDelegationInUse", err)
+	}
+}
+
+func TestDelegatorWithOpenBallotCannotPassPowerOn(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90, "c": 90})
+	dao.ProposeRule("p", "a valid description", "b")
+	dao.Delegate("c", "a")
+	dao.Vote("p", "a", true, 1)
+	if got := dao.GetProposal("p").Ballots["a"].Reputation; got != 180 {
+		t.Fatalf("a's ballot carries %d, want 180", got)
+	}
+	// c's 90 would reach b while a's ballot still counts it
+	if err := dao.DelegateChecked("a", "b"); !errors.Is(err, ErrDelegationInUse) {
+		t.Fatalf("got %v, want ErrDelegationInUse", err)
+	}
+	dao.Vote("p", "b", true, 1)
+	if got := dao.GetProposal("p").Ballots["b"].Reputation; got != 90 {
+		t.Fatalf("b's ballot carries %d, want only b's own 90", got)
+	}
+}
+
+func TestDelegationGraphAndResolvedPower(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 85, "c": 95, "e": 81})
+	dao.Delegate("a", "b")
+	dao.Delegate("b", "c")
+	graph := dao.DelegationGraph()
+	if len(graph) != 2 || graph["a"] != "b" || graph["b"] != "c" {
+		t.Fatalf("DelegationGraph = %v", graph)
+	}
+	graph["e"] = "c" // a copy, not the live map
+	if dao.GetDelegate("e") != "" {
+		t.Fatal("writing to the graph changed delegations")
+	}
+	power := dao.DelegationResolvedPower(0)
+	total := 0.0
+	for _, p := range power {
+		total += p
+	}
+	if power["c"] != 270 || power["a"] != 0 || power["b"] != 0 || power["e"] != 81 || total != 351 {
+		t.Fatalf("DelegationResolvedPower = %v", power)
+	}
+}
+
+func TestSelfDelegationRefused(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	if err := dao.DelegateChecked("f", "f"); !errors.Is(err, ErrSelfDelegation) {
+		t.Fatalf("got %v, want ErrSelfDelegation", err)
+	}
+	if len(dao.DelegationGraph()) != 0 || dao.DelegationResolvedPower(0)["f"] != 81 {
+		t.Fatal("refused self-delegation changed the graph")
+	}
+}
+
+func TestMinimumReputationToDelegate(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90})
+	dao.SetMinDelegationReputation(85)
+	if err := dao.DelegateChecked("f", "g"); !errors.Is(err, ErrDelegatorReputation) {
+		t.Fatalf("below the minimum: got %v, want ErrDelegatorReputation", err)
+	}
+	rep.Reward("f", 10)
+	if err := dao.DelegateChecked("f", "g"); err != nil {
+		t.Fatalf("above the minimum: %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package reputation
+
+import "sort"
+
+// AgentScore is one entry of a leaderboard.
+type AgentScore struct {
+	AgentID string
+	Score   int
+}
+
+// SetDomainScore records agentID's reputation within a single virtue or
+// expertise domain. Domain scores sit alongside the overall reputation and
+// do not affect voting weight; a score of zero clears the entry.
+func (c *ReputationContract) SetDomainScore(agentID string, domain string, score int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SetDomainScore", agentID, domain, score)
+	c.setDomainScore(agentID, domain, score)
+}
+
+func (c *ReputationContract) setDomainScore(agentID string, domain string, score int) {
+	if score == 0 {
+		delete(c.domainScores[domain], agentID)
+		if len(c.domainScores[domain]) == 0 {
+			delete(c.domainScores, domain)
+		}
+		return
+	}
+	if c.domainScores[domain] == nil {
+		c.domainScores[domain] = make(map[string]int)
+	}
+	c.domainScores[domain][agentID] = score
+}
+
+func (c *ReputationContract) DomainScore(agentID string, domain string) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.domainScores[domain][agentID]
+}
+
+// PrimaryDomain is the domain agentID scores highest in, ties going to the
+// alphabetically first, or "" if they have no domain scores.
+func (c *ReputationContract) PrimaryDomain(agentID string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	primary, best := "", 0
+	for domain, scores := range c.domainScores {
+		score, scored := scores[agentID]
+		if scored && (primary == "" || score > best || (score == best && domain < primary)) {
+			primary, best = domain, score
+		}
+	}
+	return primary
+}
+
+// TopAgentsByDomain returns up to n of the highest scorers in domain,
+// highest first, with ties broken by agent ID. A domain nobody has a score
+// in yields an empty slice; n <= 0 returns every scorer.
+func (c *ReputationContract) TopAgentsByDomain(domain string, n int) []AgentScore {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	scores := make([]AgentScore, 0, len(c.domainScores[domain]))
+	for agentID, score := range c.domainScores[domain] {
+		scores = append(scores, AgentScore{AgentID: agentID, Score: score})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].AgentID < scores[j].AgentID
+	})
+	if n > 0 && len(scores) > n {
+		scores = scores[:n]
+	}
+	return scores
+}
@@ -0,0 +1,38 @@
+package reputation
+
+import "testing"
+
+func TestTopAgentsByDomain(t *testing.T) {
+	rep := NewReputationContract()
+	rep.SetDomainScore("b", "justice", 50)
+	rep.SetDomainScore("a", "justice", 50)
+	rep.SetDomainScore("c", "justice", 70)
+	rep.SetDomainScore("c", "care", 90)
+	top := rep.TopAgentsByDomain("justice", 2)
+	if len(top) != 2 || top[0].AgentID != "c" || top[1].AgentID != "a" {
+		t.Fatalf("got %v, want c then a (ties by ID)", top)
+	}
+	if none := rep.TopAgentsByDomain("none", 3); none == nil || len(none) != 0 {
+		t.Fatalf("unknown domain: got %#v, want an empty slice", none)
+	}
+	rep.MintFromExpertise("e", "care", 95)
+	if all := rep.TopAgentsByDomain("care", 0); len(all) != 2 || all[0].AgentID != "e" {
+		t.Fatalf("care leaderboard %v, want e first", all)
+	}
+}
+
+func TestDomainScoresSurviveBinaryState(t *testing.T) {
+	rep := NewReputationContract()
+	rep.SetDomainScore("c", "care", 90)
+	blob, err := rep.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := NewReputationContract()
+	if err := loaded.UnmarshalBinary(blob); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.DomainScore("c", "care") != 90 {
+		t.Fatal("domain score lost in round trip")
+	}
+}
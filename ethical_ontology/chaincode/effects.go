@@ -0,0 +1,158 @@
+package reputation
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// EffectKind says which change an Effect makes.
+type EffectKind int
+
+const (
+	// EffectReward raises Target's reputation by Amount.
+	EffectReward EffectKind = iota
+	// EffectSlash lowers Target's reputation by Amount, which Target must
+	// hold.
+	EffectSlash
+	// EffectParamChange sets the governance parameter Key to Value.
+	EffectParamChange
+)
+
+func (k EffectKind) String() string {
+	switch k {
+	case EffectReward:
+		return "reward"
+	case EffectSlash:
+		return "slash"
+	case EffectParamChange:
+		return "param_change"
+	default:
+		return "unknown"
+	}
+}
+
+// Effect is a state change a proposal makes when it is enacted. It is one
+// flat struct, rather than an interface, so proposals stay serializable;
+// build one with RewardEffect, SlashEffect or ParamChangeEffect.
+type Effect struct {
+	Kind   EffectKind
+	Target string
+	Amount int
+	Key    string
+	Value  float64
+}
+
+func RewardEffect(target string, amount int) Effect {
+	return Effect{Kind: EffectReward, Target: target, Amount: amount}
+}
+
+func SlashEffect(target string, amount int) Effect {
+	return Effect{Kind: EffectSlash, Target: target, Amount: amount}
+}
+
+// ParamChangeEffect sets one of the parameters listed in paramSetters.
+// Integer parameters reject fractional values.
+func ParamChangeEffect(key string, value float64) Effect {
+	return Effect{Kind: EffectParamChange, Key: key, Value: value}
+}
+
+// paramSetter validates and applies one governance parameter.
+type paramSetter struct {
+	integer  bool
+	min, max float64
+	set      func(d *DAOContract, v float64)
+}
+
+var paramSetters = map[string]paramSetter{
+	"quorum":               {min: 0, max: 1, set: func(d *DAOContract, v float64) { d.quorum = v }},
+	"min_turnout":          {min: 0, max: math.MaxFloat64, set: func(d *DAOContract, v float64) { d.minTurnout = v }},
+	"min_reputation_share": {min: 0, max: 1, set: func(d *DAOContract, v float64) { d.minReputationShare = v }},
+	"against_multiplier":   {min: math.SmallestNonzeroFloat64, max: math.MaxFloat64, set: func(d *DAOContract, v float64) { d.againstMultiplier = v }},
+	"min_voters":           {integer: true, min: 0, max: math.MaxInt32, set: func(d *DAOContract, v float64) { d.minVoters = int(v) }},
+	"voting_period":        {integer: true, min: 0, max: math.MaxInt32, set: func(d *DAOContract, v float64) { d.votingPeriod = int(v) }},
+	"author_reward":        {integer: true, min: 0, max: math.MaxInt32, set: func(d *DAOContract, v float64) { d.authorReward = int(v) }},
+	"winning_side_reward":  {integer: true, min: 0, max: math.MaxInt32, set: func(d *DAOContract, v float64) { d.winningSideReward = int(v) }},
+}
+
+// ProposeWithEffects creates a proposal that applies effects, in order,
+// when it is enacted. Effects are checked again at enactment, since
+// reputations may have moved in the meantime.
+func (d *DAOContract) ProposeWithEffects(id string, description string, proposerID string, effects []Effect) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("ProposeWithEffects", id, description, proposerID, effects)
+	if err := d.validateEffects(effects); err != nil {
+		return err
+	}
+	prop, err := d.propose(id, "", description, proposerID)
+	if err != nil {
+		return err
+	}
+	prop.Effects = append([]Effect(nil), effects...)
+	return nil
+}
+
+// validateEffects checks the whole group against the current state before
+// any of it is applied, tracking the reputation each effect leaves behind
+// so later effects on the same target are judged on that.
+func (d *DAOContract) validateEffects(effects []Effect) error {
+	reps := make(map[string]int)
+	for i, effect := range effects {
+		invalid := func(format string, args ...any) error {
+			return fmt.Errorf("%w: effect %d (%s): %s", ErrInvalidEffect, i, effect.Kind, fmt.Sprintf(format, args...))
+		}
+		switch effect.Kind {
+		case EffectReward, EffectSlash:
+			if !d.reputation.HasToken(effect.Target) {
+				return invalid("%q is not a member", effect.Target)
+			}
+			if effect.Amount <= 0 {
+				return invalid("amount %d is not positive", effect.Amount)
+			}
+			rep, seen := reps[effect.Target]
+			if !seen {
+				rep = d.reputation.GetReputation(effect.Target)
+			}
+			if effect.Kind == EffectReward {
+				reps[effect.Target] = rep + effect.Amount
+				continue
+			}
+			if effect.Amount > rep {
+				return invalid("%q holds %d, cannot afford %d", effect.Target, rep, effect.Amount)
+			}
+			reps[effect.Target] = rep - effect.Amount
+		case EffectParamChange:
+			setter, known := paramSetters[effect.Key]
+			if !known {
+				return invalid("unknown parameter %q", effect.Key)
+			}
+			v := effect.Value
+			if math.IsNaN(v) || v < setter.min || v > setter.max || (setter.integer && v != math.Trunc(v)) {
+				return invalid("value %v out of range for %q", v, effect.Key)
+			}
+		default:
+			return invalid("unknown kind")
+		}
+	}
+	return nil
+}
+
+// applyEffects carries out prop's effects. enactBlocker has already
+// validated them as a group, so either every effect is applied or none is.
+func (d *DAOContract) applyEffects(prop *Proposal) {
+	for _, effect := range prop.Effects {
+		details := map[string]string{"kind": effect.Kind.String()}
+		switch effect.Kind {
+		case EffectReward:
+			details["reputation"] = strconv.Itoa(d.reputation.Reward(effect.Target, effect.Amount))
+		case EffectSlash:
+			details["reputation"] = strconv.Itoa(d.reputation.Slash(effect.Target, effect.Amount, "proposal "+prop.ID))
+		case EffectParamChange:
+			paramSetters[effect.Key].set(d, effect.Value)
+			details["key"] = effect.Key
+			details["value"] = strconv.FormatFloat(effect.Value, 'g', -1, 64)
+		}
+		d.emit(Event{Type: EventEffectApplied, Subject: prop.ID, Actor: effect.Target, Details: details})
+	}
+}
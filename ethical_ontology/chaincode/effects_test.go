@@ -0,0 +1,59 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func effectsDAO(t *testing.T) (*DAOContract, *ReputationContract, *manualClock) {
+	t.Helper()
+	dao, rep, clock := newTestDAO(t, 0.5, map[string]int{"a": 100, "b": 90, "c": 85})
+	rep.SetReputationCap(0)
+	dao.SetVotingPeriod(1)
+	return dao, rep, clock
+}
+
+func TestEnactmentEffects(t *testing.T) {
+	dao, rep, clock := effectsDAO(t)
+	if err := dao.ProposeWithEffects("x", "a valid description", "a", []Effect{ParamChangeEffect("nope", 1)}); !errors.Is(err, ErrInvalidEffect) {
+		t.Fatalf("unknown parameter: got %v, want ErrInvalidEffect", err)
+	}
+	effects := []Effect{RewardEffect("c", 10), SlashEffect("b", 60), ParamChangeEffect("min_voters", 1)}
+	if err := dao.ProposeWithEffects("p", "a valid description", "a", effects); err != nil {
+		t.Fatal(err)
+	}
+	dao.VoteChecked("p", "a", VoteFor, 1)
+	clock.now = 1
+	if !dao.Enact("p") {
+		t.Fatal("Enact refused")
+	}
+	if rep.GetReputation("b") != 30 || rep.GetReputation("c") != 95 || dao.Config().MinVoters != 1 {
+		t.Fatalf("b %d, c %d, MinVoters %d", rep.GetReputation("b"), rep.GetReputation("c"), dao.Config().MinVoters)
+	}
+	replayed, replayedRep, err := ReplayOperations(dao.OperationLog())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := rep.MarshalJSON()
+	got, _ := replayedRep.MarshalJSON()
+	if string(got) != string(want) || replayed.Config().MinVoters != 1 {
+		t.Fatal("replay did not reapply the effects")
+	}
+}
+
+func TestFailedEffectRollsBackEnactment(t *testing.T) {
+	dao, rep, clock := effectsDAO(t)
+	dao.ProposeWithEffects("p", "a valid description", "a", []Effect{SlashEffect("b", 60)})
+	dao.ProposeWithEffects("q", "b valid description", "a", []Effect{RewardEffect("c", 5), SlashEffect("b", 40)})
+	dao.VoteChecked("p", "a", VoteFor, 1)
+	dao.VoteChecked("q", "a", VoteFor, 1)
+	clock.now = 1
+	dao.Enact("p")
+	// b is down to 30, so q's slash can no longer be applied in full
+	if err := dao.EnactChecked("q"); !errors.Is(err, ErrInvalidEffect) {
+		t.Fatalf("got %v, want ErrInvalidEffect", err)
+	}
+	if rep.GetReputation("c") != 85 || rep.GetReputation("b") != 30 || dao.GetProposal("q").Status != StatusActive {
+		t.Fatal("earlier effects were not rolled back")
+	}
+}
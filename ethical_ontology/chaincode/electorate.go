@@ -0,0 +1,83 @@
+package reputation
+
+import "sort"
+
+// QuorumElectorate decides whose participation counts toward quorum.
+type QuorumElectorate int
+
+const (
+	// AllReputation counts every agent holding reputation. It is the default.
+	AllReputation QuorumElectorate = iota
+	// TokenHoldersOnly counts only formal members, the agents holding a
+	// token: ballots from anyone else still move approval but not turnout,
+	// voter counts or the reputation share, and the eligible reputation
+	// snapshot covers members alone.
+	TokenHoldersOnly
+)
+
+func (d *DAOContract) SetQuorumElectorate(electorate QuorumElectorate) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetQuorumElectorate", electorate)
+	d.electorate = electorate
+}
+
+// SetElectorateFrozen makes proposals created from now on record who is
+// eligible to vote at creation and accept ballots from those agents alone,
+// so later mints, rewards and releases from quarantine cannot add voters.
+func (d *DAOContract) SetElectorateFrozen(frozen bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetElectorateFrozen", frozen)
+	d.freezeElectorate = frozen
+}
+
+// eligibleVoters lists, sorted, the agents EligibleElectorate would count
+// under the current quorum electorate.
+func (d *DAOContract) eligibleVoters() []string {
+	var scored []string
+	d.reputation.IterateByReputation(true, func(agentID string, rep int) bool {
+		if rep <= 0 {
+			return false
+		}
+		scored = append(scored, agentID)
+		return true
+	})
+	// The source is locked while it iterates, so filter afterwards
+	var voters []string
+	for _, agentID := range scored {
+		if !d.reputation.IsQuarantined(agentID) && d.inElectorate(agentID) {
+			voters = append(voters, agentID)
+		}
+	}
+	sort.Strings(voters)
+	return voters
+}
+
+// inFrozenElectorate reports whether agentID may vote on prop.
+func (p *Proposal) inFrozenElectorate(agentID string) bool {
+	if !p.ElectorateFrozen {
+		return true
+	}
+	i := sort.SearchStrings(p.Electorate, agentID)
+	return i < len(p.Electorate) && p.Electorate[i] == agentID
+}
+
+// inElectorate reports whether agentID's ballot counts toward quorum.
+func (d *DAOContract) inElectorate(agentID string) bool {
+	return d.electorate != TokenHoldersOnly || d.reputation.HasToken(agentID)
+}
+
+// quorumVoters counts the voters whose ballots count toward quorum.
+func (d *DAOContract) quorumVoters(prop *Proposal) int {
+	if d.electorate == AllReputation {
+		return len(prop.Voters)
+	}
+	voters := 0
+	for agentID := range prop.Voters {
+		if d.inElectorate(agentID) {
+			voters++
+		}
+	}
+	return voters
+}
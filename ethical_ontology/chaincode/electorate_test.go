@@ -0,0 +1,46 @@
+package reputation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenHoldersOnlyQuorum(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90})
+	rep.Reward("resident", 90) // reputation without a token
+	dao.SetMinVoters(2)
+	dao.ProposeRule("p", "a valid description", "a")
+	dao.Vote("p", "a", true, 1)
+	dao.Vote("p", "resident", true, 1)
+	if res, _ := dao.GetProposalResults("p"); !res.Passes {
+		t.Fatalf("default electorate: %+v", res)
+	}
+	dao.SetQuorumElectorate(TokenHoldersOnly)
+	res, _ := dao.GetProposalResults("p")
+	if res.Passes || res.Voters != 1 || res.VotesFor <= res.Turnout {
+		t.Fatalf("token holders only: %+v; want one counted voter and the resident's weight still tallied", res)
+	}
+	if dao.Enact("p") {
+		t.Fatal("enacted without enough token-holding voters")
+	}
+	dao.ProposeRule("q", "b valid description", "a")
+	if eligible := dao.GetProposal("q").EligibleReputation; eligible != 180 {
+		t.Fatalf("eligible reputation %d, want only the token holders' 180", eligible)
+	}
+	dao.Vote("p", "b", true, 1)
+	if !dao.Enact("p") {
+		t.Fatal("second token holder's ballot did not meet quorum")
+	}
+}
+
+func TestFrozenElectorateMembers(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"c": 95, "a": 90, "b": 85, "x": 81})
+	rep.Reward("resident", 90)
+	rep.Quarantine("x")
+	dao.SetQuorumElectorate(TokenHoldersOnly)
+	dao.SetElectorateFrozen(true)
+	dao.ProposeRule("p", "a valid description", "a")
+	if got := dao.GetProposal("p").Electorate; !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Fatalf("frozen electorate %v, want the unquarantined token holders in ID order", got)
+	}
+}
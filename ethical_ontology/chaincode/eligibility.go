@@ -0,0 +1,57 @@
+package reputation
+
+// ProposalLimits throttles proposal creation. Cooldown is the seconds an
+// agent must wait between proposals; MaxActive caps open proposals across
+// the network. Zero disables either limit.
+type ProposalLimits struct {
+	Cooldown  int
+	MaxActive int
+}
+
+func (d *DAOContract) SetProposalLimits(limits ProposalLimits) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetProposalLimits", limits)
+	d.limits = limits
+}
+
+// canPropose holds every proposer precondition so ProposeRule and
+// EligibleProposers always agree.
+func (d *DAOContract) canPropose(proposerID string, now int) error {
+	if d.reputation.IsQuarantined(proposerID) {
+		return ErrQuarantined
+	}
+	if !d.hasRole(proposerID, ActionPropose) {
+		return ErrInsufficientRole
+	}
+	if last, ok := d.lastProposed[proposerID]; ok && d.limits.Cooldown > 0 && now-last < d.limits.Cooldown {
+		return ErrProposerCooldown
+	}
+	if d.limits.MaxActive > 0 && d.activeCount() >= d.limits.MaxActive {
+		return ErrTooManyActive
+	}
+	return nil
+}
+
+func (d *DAOContract) activeCount() int {
+	count := 0
+	for _, prop := range d.proposals {
+		if prop.Active {
+			count++
+		}
+	}
+	return count
+}
+
+// EligibleProposers lists, in ID order, the agents who could open a proposal at now.
+func (d *DAOContract) EligibleProposers(now int) []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var eligible []string
+	for _, agentID := range d.reputation.Agents() {
+		if d.canPropose(agentID, now) == nil {
+			eligible = append(eligible, agentID)
+		}
+	}
+	return eligible
+}
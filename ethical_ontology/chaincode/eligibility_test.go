@@ -0,0 +1,40 @@
+package reputation
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestProposalLimits(t *testing.T) {
+	dao, _, clock := newTestDAO(t, 0.6, map[string]int{"f": 81})
+	clock.now = 100
+	dao.SetProposalLimits(ProposalLimits{Cooldown: 50, MaxActive: 2})
+	dao.ProposeRule("p", "a valid description", "f")
+	if err := dao.ProposeRuleChecked("q", "a valid description", "f"); !errors.Is(err, ErrProposerCooldown) {
+		t.Fatalf("within cooldown: got %v, want ErrProposerCooldown", err)
+	}
+	clock.now = 150
+	dao.ProposeRule("q", "a valid description", "f")
+	clock.now = 200
+	if err := dao.ProposeRuleChecked("r", "a valid description", "f"); !errors.Is(err, ErrTooManyActive) {
+		t.Fatalf("over the active limit: got %v, want ErrTooManyActive", err)
+	}
+}
+
+func TestEligibleProposers(t *testing.T) {
+	dao, _, clock := newTestDAO(t, 0.6, map[string]int{"f": 81, "g": 82})
+	clock.now = 100
+	dao.SetProposalLimits(ProposalLimits{Cooldown: 50})
+	dao.ProposeRule("p", "a valid description", "f")
+	if got := dao.EligibleProposers(120); !slices.Equal(got, []string{"g"}) {
+		t.Fatalf("during f's cooldown: %v", got)
+	}
+	if got := dao.EligibleProposers(150); !slices.Equal(got, []string{"f", "g"}) {
+		t.Fatalf("after the cooldown: %v", got)
+	}
+	dao.SetProposalLimits(ProposalLimits{MaxActive: 1})
+	if got := dao.EligibleProposers(150); len(got) != 0 {
+		t.Fatalf("with the active limit reached: %v", got)
+	}
+}
@@ -0,0 +1,46 @@
+package reputation
+
+// epochUsage is the quadratic weight an agent has cast during one epoch.
+type epochUsage struct {
+	Epoch int
+	Used  int64 // fixed-point, see WeightScale
+}
+
+// SetEpochWeightCap limits the total quadratic weight any agent may cast
+// across all proposals within each epochLength-second epoch, whatever their
+// reputation. A zero maxWeight or epochLength disables the cap.
+func (d *DAOContract) SetEpochWeightCap(maxWeight float64, epochLength int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetEpochWeightCap", maxWeight, epochLength)
+	d.maxWeightPerEpoch = int64(maxWeight * WeightScale)
+	d.epochLength = epochLength
+}
+
+func (d *DAOContract) epochCapped() bool {
+	return d.maxWeightPerEpoch > 0 && d.epochLength > 0
+}
+
+// epochWeightUsed is agentID's consumed weight in the epoch containing now;
+// usage recorded in an earlier epoch counts as zero.
+func (d *DAOContract) epochWeightUsed(agentID string, now int) int64 {
+	usage := d.epochUsage[agentID]
+	if usage.Epoch != now/d.epochLength {
+		return 0
+	}
+	return usage.Used
+}
+
+// consumeEpochWeight charges weight against agentID's budget, reporting false
+// without charging if it would exceed the cap.
+func (d *DAOContract) consumeEpochWeight(agentID string, weight int64, now int) bool {
+	if !d.epochCapped() {
+		return true
+	}
+	used := d.epochWeightUsed(agentID, now) + weight
+	if used > d.maxWeightPerEpoch {
+		return false
+	}
+	d.epochUsage[agentID] = epochUsage{Epoch: now / d.epochLength, Used: used}
+	return true
+}
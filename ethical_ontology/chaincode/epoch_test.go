@@ -0,0 +1,31 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEpochWeightCap(t *testing.T) {
+	dao, _, clock := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	// sqrt(81) = 9 per unit of weight against a cap of 30 per 100 seconds
+	dao.SetEpochWeightCap(30, 100)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.ProposeRule("q", "a valid description 2", "f")
+	dao.ProposeRule("s", "a valid description 3", "f")
+	if !dao.Vote("p", "f", true, 2) {
+		t.Fatal("18 of 30 refused")
+	}
+	if err := dao.VoteChecked("q", "f", VoteFor, 2); !errors.Is(err, ErrEpochWeightExceeded) {
+		t.Fatalf("36 of 30: got %v, want ErrEpochWeightExceeded", err)
+	}
+	if !dao.Vote("q", "f", true, 1) {
+		t.Fatal("27 of 30 refused; a refused vote must not be charged")
+	}
+	if dao.Vote("s", "f", true, 1) {
+		t.Fatal("36 of 30 accepted")
+	}
+	clock.now = 100
+	if !dao.Vote("s", "f", true, 3) {
+		t.Fatal("budget did not reset in the next epoch")
+	}
+}
@@ -0,0 +1,50 @@
+package reputation
+
+import "errors"
+
+var (
+	ErrProposalExists       = errors.New("proposal already exists")
+	ErrInsufficientRole     = errors.New("agent lacks the required role")
+	ErrInvalidDescription   = errors.New("invalid proposal description")
+	ErrProposerCooldown     = errors.New("proposer is still in cooldown")
+	ErrTooManyActive        = errors.New("active proposal limit reached")
+	ErrProposalNotFound     = errors.New("proposal not found")
+	ErrProposalInactive     = errors.New("proposal is not active")
+	ErrVotingPaused         = errors.New("voting on proposal is paused")
+	ErrVotingClosed         = errors.New("voting period has ended")
+	ErrAlreadyVoted         = errors.New("agent has already voted")
+	ErrVoteDelegated        = errors.New("agent has delegated their vote")
+	ErrQuarantined          = errors.New("agent is quarantined")
+	ErrNotAdmin             = errors.New("agent is not an admin")
+	ErrSelfDelegation       = errors.New("agent cannot delegate to themselves")
+	ErrDelegationCycle      = errors.New("delegation would create a cycle")
+	ErrDelegationCap        = errors.New("delegate would exceed the delegation cap")
+	ErrEpochWeightExceeded  = errors.New("vote would exceed the agent's weight cap for this epoch")
+	ErrNotPassing           = errors.New("proposal does not meet the enactment criteria")
+	ErrConflictEnacted      = errors.New("a conflicting proposal has already passed")
+	ErrGenesisSignature     = errors.New("genesis allocation signature is invalid")
+	ErrGenesisRejected      = errors.New("genesis only applies to a contract with no prior activity")
+	ErrDelegatorReputation  = errors.New("delegator lacks the reputation required to delegate")
+	ErrInvalidTally         = errors.New("proposal tally contains NaN or Inf")
+	ErrInvalidSplit         = errors.New("split fractions must be positive and sum to at most 1")
+	ErrChallengePending     = errors.New("agent already faces an open reputation challenge")
+	ErrInsufficientStake    = errors.New("stake must be positive and within the target's reputation")
+	ErrSoulbound            = errors.New("tokens and reputation are soulbound and cannot be transferred")
+	ErrInvalidTransfer      = errors.New("invalid reputation transfer")
+	ErrInvariantViolation   = errors.New("state invariant violated")
+	ErrInvalidProposalID    = errors.New("invalid proposal ID")
+	ErrAlreadyCommitted     = errors.New("agent has a pending vote commitment")
+	ErrNoCommitment         = errors.New("agent has no vote commitment to reveal")
+	ErrCommitmentMismatch   = errors.New("revealed vote does not match the commitment")
+	ErrRevealPending        = errors.New("vote commitments are still awaiting reveal")
+	ErrSelfVote             = errors.New("proposers may not vote on their own proposals")
+	ErrInvalidOperation     = errors.New("operation cannot be replayed")
+	ErrVotingExtended       = errors.New("tied proposal reopened for more votes")
+	ErrInvalidEffect        = errors.New("proposal effect cannot be applied")
+	ErrDelegationInUse      = errors.New("delegated power is already carried by a ballot on an open proposal")
+	ErrNotInElectorate      = errors.New("agent is outside the proposal's frozen electorate")
+	ErrNoRevealWindow       = errors.New("commit-reveal voting needs a proposal deadline and a reveal window")
+	ErrNotRecoveryAuthority = errors.New("agent is not the recovery authority")
+	ErrInvalidWeight        = errors.New("vote weight must be at least one")
+	ErrWeightOverflow       = errors.New("vote weight is too large to tally")
+)
@@ -0,0 +1,81 @@
+package reputation
+
+import "sync"
+
+const (
+	EventTokenMinted            = "token_minted"
+	EventTokenRevoked           = "token_revoked"
+	EventReputationSlashed      = "reputation_slashed"
+	EventAgentQuarantined       = "agent_quarantined"
+	EventAgentReleased          = "agent_released"
+	EventVotingPaused           = "voting_paused"
+	EventVotingResumed          = "voting_resumed"
+	EventAuthorRewarded         = "author_rewarded"
+	EventProposalEnacted        = "proposal_enacted"
+	EventProposalRejected       = "proposal_rejected"
+	EventProposalExpired        = "proposal_expired"
+	EventProposalReopened       = "proposal_reopened"
+	EventProposalChallenged     = "proposal_challenged"
+	EventProposalDisputed       = "proposal_disputed"
+	EventProposalFastTracked    = "proposal_fast_tracked"
+	EventProposalSuperseded     = "proposal_superseded"
+	EventGenesisApplied         = "genesis_applied"
+	EventReputationChallenged   = "reputation_challenged"
+	EventStakeForfeited         = "stake_forfeited"
+	EventStakeReturned          = "stake_returned"
+	EventReputationTransferred  = "reputation_transferred"
+	EventAccountsMerged         = "accounts_merged"
+	EventVoterRewarded          = "voter_rewarded"
+	EventVoteCommitted          = "vote_committed"
+	EventVotingExtended         = "voting_extended"
+	EventProposalFlagged        = "proposal_flagged"
+	EventVotingClosed           = "voting_closed"
+	EventProposalCosigned       = "proposal_cosigned"
+	EventProposalActivated      = "proposal_activated"
+	EventSponsorshipTransferred = "sponsorship_transferred"
+	EventEffectApplied          = "effect_applied"
+)
+
+// Event is an audit record of a state change. Subject is the agent or
+// proposal affected, Actor the agent who caused it.
+type Event struct {
+	Type    string
+	Subject string
+	Actor   string
+	Details map[string]string
+}
+
+// eventLog is embedded in the contracts to give them an append-only audit trail.
+type eventLog struct {
+	eventsMu sync.Mutex
+	events   []Event
+}
+
+func (l *eventLog) emit(e Event) {
+	l.eventsMu.Lock()
+	defer l.eventsMu.Unlock()
+	l.events = append(l.events, e)
+}
+
+// Events returns a copy of the audit trail in emission order.
+func (l *eventLog) Events() []Event {
+	l.eventsMu.Lock()
+	defer l.eventsMu.Unlock()
+	out := make([]Event, len(l.events))
+	copy(out, l.events)
+	return out
+}
+
+func (l *eventLog) eventCount() int {
+	l.eventsMu.Lock()
+	defer l.eventsMu.Unlock()
+	return len(l.events)
+}
+
+// discardEventsAfter drops events emitted after the first n, for operations
+// that roll themselves back.
+func (l *eventLog) discardEventsAfter(n int) {
+	l.eventsMu.Lock()
+	defer l.eventsMu.Unlock()
+	l.events = l.events[:n]
+}
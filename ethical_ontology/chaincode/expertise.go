@@ -0,0 +1,112 @@
+package reputation
+
+import "strconv"
+
+// ExpertiseOracle answers proof-of-expertise queries, typically over the
+// network, so it may fail.
+type ExpertiseOracle interface {
+	VerifyExpertise(agentID string, domain string) (bool, error)
+}
+
+// OracleFailurePolicy decides how an oracle error is treated.
+type OracleFailurePolicy int
+
+const (
+	FailClosed OracleFailurePolicy = iota // an error leaves the agent unverified
+	FailOpen                              // an error counts the agent as verified
+)
+
+// SetExpertiseOracle routes VerifyExpertise through oracle, applying policy
+// when it fails. A nil oracle restores the mock.
+func (c *ReputationContract) SetExpertiseOracle(oracle ExpertiseOracle, policy OracleFailurePolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.oracle = oracle
+	c.oracleFailure = policy
+	clear(c.expertiseCache)
+}
+
+func (c *ReputationContract) VerifyExpertise(agentID string, domain string) bool {
+	verified, _ := c.VerifyExpertiseChecked(agentID, domain)
+	return verified
+}
+
+// VerifyExpertiseChecked returns the verification decision along with any
+// oracle error, so callers can log failures the policy papered over.
+func (c *ReputationContract) VerifyExpertiseChecked(agentID string, domain string) (bool, error) {
+	c.mu.RLock()
+	oracle, policy := c.oracle, c.oracleFailure
+	cached, hit := c.cachedExpertise(agentID, domain)
+	c.mu.RUnlock()
+	if oracle == nil {
+		// Mock external query
+		return true, nil
+	}
+	if hit {
+		return cached, nil
+	}
+	// The oracle is queried without the lock held; it may be slow
+	verified, err := oracle.VerifyExpertise(agentID, domain)
+	if err != nil {
+		// Failures are not cached so the next call retries the oracle
+		return policy == FailOpen, err
+	}
+	c.mu.Lock()
+	c.cacheExpertise(agentID, domain, verified)
+	c.mu.Unlock()
+	return verified, nil
+}
+
+// SetDomainWeight scales the base score granted by MintFromExpertise for
+// domain; domains without a weight use 1.
+func (c *ReputationContract) SetDomainWeight(domain string, weight float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SetDomainWeight", domain, weight)
+	if weight == 1 {
+		delete(c.domainWeights, domain)
+		return
+	}
+	c.domainWeights[domain] = weight
+}
+
+// MintFromExpertise onboards a domain expert: if VerifyExpertise confirms
+// agentID in domain, it mints their token with baseScore scaled by the
+// domain weight, which also becomes their score in that domain. The virtue
+// threshold does not apply, but score rules and vouching requirements do.
+func (c *ReputationContract) MintFromExpertise(agentID string, domain string, baseScore int) bool {
+	c.mu.RLock()
+	minted := c.tokens[agentID]
+	c.mu.RUnlock()
+	if minted || !c.VerifyExpertise(agentID, domain) {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Recorded only once verified, so replay needs no oracle
+	c.record("MintFromExpertise", agentID, domain, baseScore)
+	weight, scaled := c.domainWeights[domain]
+	if !scaled {
+		weight = 1
+	}
+	score, valid := c.scoreRules.normalizeScore(int(float64(baseScore) * weight))
+	// Re-checked: the token may have been minted while the oracle was queried
+	if !valid || score <= 0 || c.tokens[agentID] || len(c.vouches[agentID]) < c.vouchesRequired {
+		return false
+	}
+	c.tokens[agentID] = true
+	c.setReputation(agentID, score)
+	delete(c.vouches, agentID)
+	c.attestations[agentID] = "expertise:" + domain
+	c.setDomainScore(agentID, domain, score)
+	c.emit(Event{
+		Type:    EventTokenMinted,
+		Subject: agentID,
+		Details: map[string]string{
+			"expertise_score": strconv.Itoa(score),
+			"domain":          domain,
+		},
+	})
+	c.logger.Info("token_minted", "agent", agentID, "domain", domain, "score", score)
+	return true
+}
@@ -0,0 +1,50 @@
+package reputation
+
+type expertiseResult struct {
+	verified bool
+	expires  int
+}
+
+// SetClock sets the time source for expiring cached expertise results.
+func (c *ReputationContract) SetClock(clock Clock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.clock = clock
+}
+
+// SetExpertiseCacheTTL caches successful oracle answers for ttl seconds per
+// (agent, domain); zero disables caching and drops cached answers.
+func (c *ReputationContract) SetExpertiseCacheTTL(ttl int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.expertiseTTL = ttl
+	if ttl <= 0 {
+		clear(c.expertiseCache)
+	}
+}
+
+// InvalidateExpertise forgets every cached answer for agentID, so the next
+// verification queries the oracle.
+func (c *ReputationContract) InvalidateExpertise(agentID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.expertiseCache, agentID)
+}
+
+func (c *ReputationContract) cachedExpertise(agentID string, domain string) (bool, bool) {
+	result, ok := c.expertiseCache[agentID][domain]
+	if !ok || c.expertiseTTL <= 0 || c.clock.Now() >= result.expires {
+		return false, false
+	}
+	return result.verified, true
+}
+
+func (c *ReputationContract) cacheExpertise(agentID string, domain string, verified bool) {
+	if c.expertiseTTL <= 0 {
+		return
+	}
+	if c.expertiseCache[agentID] == nil {
+		c.expertiseCache[agentID] = make(map[string]expertiseResult)
+	}
+	c.expertiseCache[agentID][domain] = expertiseResult{verified: verified, expires: c.clock.Now() + c.expertiseTTL}
+}
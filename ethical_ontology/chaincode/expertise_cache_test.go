@@ -0,0 +1,49 @@
+package reputation
+
+import "testing"
+
+// countingOracle verifies everyone and counts the queries it answers.
+type countingOracle struct{ queries *int }
+
+func (o countingOracle) VerifyExpertise(string, string) (bool, error) {
+	*o.queries++
+	return true, nil
+}
+
+func TestExpertiseCacheTTL(t *testing.T) {
+	queries := 0
+	clock := &manualClock{now: 100}
+	rep := NewReputationContract()
+	rep.SetClock(clock)
+	rep.SetExpertiseOracle(countingOracle{&queries}, FailClosed)
+	rep.SetExpertiseCacheTTL(10)
+	rep.VerifyExpertise("a", "x")
+	rep.VerifyExpertise("a", "x")
+	if queries != 1 {
+		t.Fatalf("%d oracle queries, want 1 with a warm cache", queries)
+	}
+	rep.InvalidateExpertise("a")
+	rep.VerifyExpertise("a", "x")
+	if queries != 2 {
+		t.Fatalf("%d oracle queries after invalidation, want 2", queries)
+	}
+	clock.now = 111
+	rep.VerifyExpertise("a", "x")
+	if queries != 3 {
+		t.Fatalf("%d oracle queries after expiry, want 3", queries)
+	}
+}
+
+func TestExpertiseCacheIsNotState(t *testing.T) {
+	rep := NewReputationContract()
+	rep.SetExpertiseCacheTTL(10)
+	rep.VerifyExpertise("a", "x")
+	blob, _ := rep.MarshalJSON()
+	var loaded ReputationContract
+	if err := loaded.UnmarshalJSON(blob); err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.VerifyExpertise("a", "x") {
+		t.Fatal("loaded contract could not verify")
+	}
+}
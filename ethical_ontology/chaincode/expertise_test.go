@@ -0,0 +1,55 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+// failingOracle fails every query with err.
+type failingOracle struct{ err error }
+
+func (o failingOracle) VerifyExpertise(string, string) (bool, error) { return false, o.err }
+
+func TestOracleFailurePolicy(t *testing.T) {
+	rep := NewReputationContract()
+	if !rep.VerifyExpertise("a", "x") {
+		t.Fatal("the default mock should verify")
+	}
+	timeout := errors.New("timeout")
+	rep.SetExpertiseOracle(failingOracle{timeout}, FailClosed)
+	if verified, err := rep.VerifyExpertiseChecked("a", "x"); verified || !errors.Is(err, timeout) {
+		t.Fatalf("fail closed: %v, %v", verified, err)
+	}
+	rep.SetExpertiseOracle(failingOracle{timeout}, FailOpen)
+	if verified, err := rep.VerifyExpertiseChecked("a", "x"); !verified || !errors.Is(err, timeout) {
+		t.Fatalf("fail open: %v, %v", verified, err)
+	}
+}
+
+// expertOracle verifies exactly the "agent/domain" pairs it holds.
+type expertOracle map[string]bool
+
+func (o expertOracle) VerifyExpertise(agentID string, domain string) (bool, error) {
+	return o[agentID+"/"+domain], nil
+}
+
+func TestMintFromExpertise(t *testing.T) {
+	rep := NewReputationContract()
+	rep.SetExpertiseOracle(expertOracle{"e/ethics": true}, FailClosed)
+	rep.SetDomainWeight("ethics", 1.5)
+	if rep.MintFromExpertise("x", "ethics", 40) {
+		t.Fatal("minted without verified expertise")
+	}
+	if !rep.MintFromExpertise("e", "ethics", 40) {
+		t.Fatal("verified expert refused")
+	}
+	if rep.GetReputation("e") != 60 || rep.GetAttestation("e") != "expertise:ethics" {
+		t.Fatalf("reputation %d, attestation %q", rep.GetReputation("e"), rep.GetAttestation("e"))
+	}
+	if rep.MintFromExpertise("e", "ethics", 40) {
+		t.Fatal("minted twice")
+	}
+	if rep.Config().DomainWeights["ethics"] != 1.5 {
+		t.Fatal("domain weight missing from Config")
+	}
+}
@@ -0,0 +1,71 @@
+package reputation
+
+import "strconv"
+
+// FastTrackRules trade a shorter voting window and lower participation for
+// a stricter approval threshold. They only ever tighten approval and loosen
+// participation relative to the contract defaults.
+type FastTrackRules struct {
+	VotingPeriod int
+	MinTurnout   float64
+	MinVoters    int
+	Threshold    float64
+}
+
+var DefaultFastTrackRules = FastTrackRules{
+	VotingPeriod: 24 * 60 * 60,
+	Threshold:    2.0 / 3.0,
+}
+
+func (d *DAOContract) SetFastTrackRules(rules FastTrackRules) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetFastTrackRules", rules)
+	d.fastTrack = rules
+}
+
+// ProposeRuleFastTrack creates an emergency proposal on an admin's
+// authority. The admin is recorded as its proposer, so the fast track can't
+// be lent to another agent.
+func (d *DAOContract) ProposeRuleFastTrack(id string, description string, adminID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("ProposeRuleFastTrack", id, description, adminID)
+	if !d.admins[adminID] {
+		return ErrNotAdmin
+	}
+	prop, err := d.propose(id, "", description, adminID)
+	if err != nil {
+		return err
+	}
+	prop.FastTrack = true
+	if d.fastTrack.VotingPeriod > 0 {
+		prop.Deadline = prop.CreatedAt + d.fastTrack.VotingPeriod
+	}
+	d.emit(Event{
+		Type:    EventProposalFastTracked,
+		Subject: prop.ID,
+		Actor:   adminID,
+		Details: map[string]string{"deadline": strconv.Itoa(prop.Deadline)},
+	})
+	return nil
+}
+
+// policyContext gives the policy the thresholds that apply to prop.
+func (d *DAOContract) policyContext(prop *Proposal, results ProposalResults) PolicyContext {
+	ctx := PolicyContext{
+		Results:            results,
+		Quorum:             d.quorum,
+		MinTurnout:         d.minTurnout,
+		MinVoters:          d.minVoters,
+		MinReputationShare: d.minReputationShare,
+	}
+	// The curve can raise the contract-wide minimum but never lower it
+	ctx.MinTurnout = max(ctx.MinTurnout, prop.AdaptiveTurnout)
+	if prop.FastTrack {
+		ctx.Quorum = max(ctx.Quorum, d.fastTrack.Threshold)
+		ctx.MinTurnout = min(ctx.MinTurnout, d.fastTrack.MinTurnout)
+		ctx.MinVoters = min(ctx.MinVoters, d.fastTrack.MinVoters)
+	}
+	return ctx
+}
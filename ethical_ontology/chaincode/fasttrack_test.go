@@ -0,0 +1,57 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func fastTrackDAO(t *testing.T) *DAOContract {
+	t.Helper()
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"root": 81, "f": 81, "g": 81, "h": 81})
+	dao.AddAdmin("root")
+	dao.SetMinVoters(3)
+	dao.SetVotingPeriod(1_000_000)
+	return dao
+}
+
+func TestFastTrackThresholds(t *testing.T) {
+	dao := fastTrackDAO(t)
+	dao.ProposeRule("n", "a valid description", "f")
+	if err := dao.ProposeRuleFastTrack("x", "a valid description", "root"); err != nil {
+		t.Fatalf("admin fast track refused: %v", err)
+	}
+	if dao.GetProposal("x").Deadline >= dao.GetProposal("n").Deadline {
+		t.Fatal("fast track did not shorten the deadline")
+	}
+	dao.Vote("n", "f", true, 1)
+	dao.Vote("x", "f", true, 1)
+	if pass, _ := dao.WouldPassNow("n"); pass {
+		t.Fatal("normal proposal passed below the minimum voters")
+	}
+	if pass, reason := dao.WouldPassNow("x"); !pass {
+		t.Fatalf("fast track should waive the minimum voters: %s", reason)
+	}
+	// A simple majority is enough normally but not under the supermajority
+	dao.Vote("x", "g", false, 1)
+	dao.Vote("n", "g", true, 1)
+	dao.Vote("n", "h", false, 1)
+	if pass, reason := dao.WouldPassNow("x"); pass {
+		t.Fatalf("fast track passed without a supermajority: %s", reason)
+	}
+	if !dao.Enact("n") {
+		t.Fatal("normal proposal with a majority refused")
+	}
+}
+
+func TestFastTrackIsProposedByTheAdmin(t *testing.T) {
+	dao := fastTrackDAO(t)
+	if err := dao.ProposeRuleFastTrack("x", "a valid description", "f"); !errors.Is(err, ErrNotAdmin) {
+		t.Fatalf("non-admin: got %v, want ErrNotAdmin", err)
+	}
+	if err := dao.ProposeRuleFastTrack("x", "a valid description", "root"); err != nil {
+		t.Fatal(err)
+	}
+	if proposer := dao.GetProposal("x").ProposerID; proposer != "root" {
+		t.Fatalf("proposer = %q, want the admin", proposer)
+	}
+}
@@ -0,0 +1,36 @@
+package reputation
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNegativeReputationCarriesNoWeight(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90})
+	rep.reputations["g"] = -5 // corrupted ledger entry
+	if v := rep.QuadraticVote("g", 3); v != 0 {
+		t.Fatalf("QuadraticVote with negative reputation = %v, want 0", v)
+	}
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "g", true, 3)
+	dao.Vote("p", "f", false, 1)
+	results, _ := dao.GetProposalResults("p")
+	if math.IsNaN(results.Approval) || results.VotesFor != 0 {
+		t.Fatalf("results = %+v", results)
+	}
+}
+
+func TestNonFiniteTallyIsNotEnacted(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90})
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "f", true, 1)
+	dao.Vote("p", "g", false, 1)
+	dao.SetAgainstMultiplier(math.Inf(1))
+	if err := dao.EnactChecked("p"); !errors.Is(err, ErrInvalidTally) {
+		t.Fatalf("got %v, want ErrInvalidTally", err)
+	}
+	if status := dao.GetProposal("p").Status; status != StatusActive {
+		t.Fatalf("a non-finite tally closed the proposal as %v", status)
+	}
+}
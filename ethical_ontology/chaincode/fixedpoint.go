@@ -0,0 +1,53 @@
+package reputation
+
+import (
+	"math"
+	"math/big"
+)
+
+// WeightScale is the fixed-point resolution of stored vote weight: one unit
+// of quadratic weight is held as WeightScale integer units, so tallies add
+// exactly and in any order on every peer.
+const WeightScale = 1_000_000
+
+var weightScaleSquared = big.NewInt(WeightScale * WeightScale)
+
+// fixedQuadraticWeight is voteWeight * sqrt(rep) in WeightScale units,
+// truncated. The square root is taken on integers so no float rounding can
+// differ between nodes.
+func fixedQuadraticWeight(voteWeight int, rep int) int64 {
+	return fixedWeight(voteWeight, rep).Int64()
+}
+
+// checkedQuadraticWeight is fixedQuadraticWeight for a ballot being cast. It
+// refuses non-positive weights, which the tally invariants and IsDecidable
+// rely on never seeing, and weights whose product won't fit in an int64.
+func checkedQuadraticWeight(voteWeight int, rep int) (int64, error) {
+	if voteWeight <= 0 {
+		return 0, ErrInvalidWeight
+	}
+	w := fixedWeight(voteWeight, rep)
+	if !w.IsInt64() {
+		return 0, ErrWeightOverflow
+	}
+	return w.Int64(), nil
+}
+
+func fixedWeight(voteWeight int, rep int) *big.Int {
+	if rep <= 0 {
+		return new(big.Int)
+	}
+	root := new(big.Int).Mul(big.NewInt(int64(rep)), weightScaleSquared)
+	root.Sqrt(root)
+	return root.Mul(root, big.NewInt(int64(voteWeight)))
+}
+
+// weightFits reports whether w can be added to total without overflowing.
+func weightFits(total int64, w int64) bool {
+	return w <= math.MaxInt64-total
+}
+
+// weightToFloat converts fixed-point weight for the float-valued API.
+func weightToFloat(w int64) float64 {
+	return float64(w) / WeightScale
+}
@@ -0,0 +1,62 @@
+package reputation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFixedQuadraticWeight(t *testing.T) {
+	if got := fixedQuadraticWeight(1, 2); got != 1414213 {
+		t.Fatalf("fixedQuadraticWeight(1, 2) = %d, want 1414213", got)
+	}
+	if got := fixedQuadraticWeight(3, 0); got != 0 {
+		t.Fatalf("weight without reputation = %d, want 0", got)
+	}
+}
+
+func TestTallyIsOrderIndependent(t *testing.T) {
+	var totals []int64
+	for _, reverse := range []bool{false, true} {
+		scores := map[string]int{}
+		for i := 0; i < 20; i++ {
+			scores[fmt.Sprint("agent", i)] = 81 + i
+		}
+		dao, _, _ := newTestDAO(t, 0.6, scores)
+		dao.ProposeRule("p", "a valid description", "agent0")
+		for i := 0; i < 20; i++ {
+			j := i
+			if reverse {
+				j = 19 - i
+			}
+			if !dao.Vote("p", fmt.Sprint("agent", j), true, j+1) {
+				t.Fatalf("vote by agent%d refused", j)
+			}
+		}
+		totals = append(totals, dao.GetProposal("p").ForWeight)
+	}
+	if totals[0] != totals[1] {
+		t.Fatalf("tally depends on vote order: %d vs %d", totals[0], totals[1])
+	}
+}
+
+func TestVoteRejectsInvalidWeight(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"alice": 90, "bob": 90})
+	dao.ProposeRule("p", "a valid description", "alice")
+	for _, weight := range []int{0, -1, math.MinInt} {
+		if err := dao.VoteChecked("p", "bob", VoteAgainst, weight); !errors.Is(err, ErrInvalidWeight) {
+			t.Fatalf("weight %d: got %v, want ErrInvalidWeight", weight, err)
+		}
+	}
+	if err := dao.VoteChecked("p", "bob", VoteFor, math.MaxInt); !errors.Is(err, ErrWeightOverflow) {
+		t.Fatalf("overflowing weight: got %v, want ErrWeightOverflow", err)
+	}
+	prop := dao.GetProposal("p")
+	if prop.Voters["bob"] || prop.ForWeight != 0 || prop.AgainstWeight != 0 {
+		t.Fatalf("refused ballots reached the tally: %+v", prop)
+	}
+	if err := dao.VoteChecked("p", "bob", VoteFor, 2); err != nil {
+		t.Fatalf("valid vote after refusals: %v", err)
+	}
+}
@@ -0,0 +1,26 @@
+package reputation
+
+import "testing"
+
+func TestReputationFloorLimitsSlashAndDecay(t *testing.T) {
+	rep := NewReputationContract()
+	rep.MintToken("a", 90)
+	rep.MintToken("b", 90)
+	rep.SetReputationFloor("a", 50)
+	if got := rep.Slash("a", 80, "hostile"); got != 50 {
+		t.Fatalf("protected agent slashed to %d, want the floor of 50", got)
+	}
+	if got := rep.Slash("b", 200, "x"); got != 0 {
+		t.Fatalf("unprotected agent slashed to %d, want 0", got)
+	}
+	rep.Decay(0.5)
+	if got := rep.GetReputation("a"); got != 50 {
+		t.Fatalf("decay took a protected agent to %d", got)
+	}
+	// A floor above the current score doesn't raise it, but still holds
+	rep.SetReputationFloor("a", 60)
+	rep.Slash("a", 5, "")
+	if got := rep.GetReputation("a"); got != 50 {
+		t.Fatalf("reputation %d after slashing below a raised floor, want 50", got)
+	}
+}
@@ -0,0 +1,61 @@
+package reputation
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// SetGenesisKey configures the key that must sign a genesis allocation.
+func (c *ReputationContract) SetGenesisKey(key ed25519.PublicKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SetGenesisKey", key)
+	c.genesisKey = key
+}
+
+// GenesisMessage is the canonical encoding of alloc that the genesis key
+// signs: agents in sorted order, each length-prefixed with its score.
+func GenesisMessage(alloc map[string]int) []byte {
+	agents := make([]string, 0, len(alloc))
+	for agentID := range alloc {
+		agents = append(agents, agentID)
+	}
+	sort.Strings(agents)
+	msg := appendField(nil, []byte(genesisTag))
+	for _, agentID := range agents {
+		msg = appendField(msg, []byte(agentID))
+		msg = binary.BigEndian.AppendUint64(msg, uint64(int64(alloc[agentID])))
+	}
+	return msg
+}
+
+// InitGenesis seeds reputation and tokens from a signed allocation. It only
+// succeeds once, on a contract with no prior mints, vouches or events; the
+// allocation bypasses the usual minting threshold but not the reputation cap.
+func (c *ReputationContract) InitGenesis(alloc map[string]int, signature []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("InitGenesis", alloc, signature)
+	if c.genesisApplied || len(c.reputations) > 0 || len(c.tokens) > 0 || len(c.vouches) > 0 || len(c.Events()) > 0 {
+		return ErrGenesisRejected
+	}
+	if len(c.genesisKey) != ed25519.PublicKeySize || !ed25519.Verify(c.genesisKey, GenesisMessage(alloc), signature) {
+		return ErrGenesisSignature
+	}
+	for agentID, score := range alloc {
+		if score <= 0 || (c.reputationCap > 0 && score > c.reputationCap) {
+			return fmt.Errorf("genesis score %d for %q is out of range", score, agentID)
+		}
+	}
+	for agentID, score := range alloc {
+		c.tokens[agentID] = true
+		c.setReputation(agentID, score)
+	}
+	c.genesisApplied = true
+	c.emit(Event{Type: EventGenesisApplied, Details: map[string]string{"agents": strconv.Itoa(len(alloc))}})
+	c.logger.Info("genesis_applied", "agents", len(alloc))
+	return nil
+}
@@ -0,0 +1,41 @@
+package reputation
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+)
+
+func TestInitGenesis(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alloc := map[string]int{"a": 50, "b": 95}
+	sig := ed25519.Sign(priv, GenesisMessage(alloc))
+	rep := NewReputationContract()
+	rep.SetGenesisKey(pub)
+	if err := rep.InitGenesis(map[string]int{"a": 60, "b": 95}, sig); !errors.Is(err, ErrGenesisSignature) {
+		t.Fatalf("altered allocation: got %v, want ErrGenesisSignature", err)
+	}
+	if err := rep.InitGenesis(alloc, sig); err != nil {
+		t.Fatal(err)
+	}
+	if rep.GetReputation("a") != 50 || rep.GetReputation("b") != 95 {
+		t.Fatalf("allocation not applied: %v", rep.GetReputations([]string{"a", "b"}))
+	}
+	if err := rep.InitGenesis(alloc, sig); !errors.Is(err, ErrGenesisRejected) {
+		t.Fatalf("second genesis: got %v, want ErrGenesisRejected", err)
+	}
+}
+
+func TestGenesisRefusedOnceActive(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	alloc := map[string]int{"a": 50}
+	rep := NewReputationContract()
+	rep.SetGenesisKey(pub)
+	rep.MintToken("z", 90)
+	if err := rep.InitGenesis(alloc, ed25519.Sign(priv, GenesisMessage(alloc))); !errors.Is(err, ErrGenesisRejected) {
+		t.Fatalf("genesis after minting: got %v, want ErrGenesisRejected", err)
+	}
+}
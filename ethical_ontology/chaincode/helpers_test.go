@@ -0,0 +1,107 @@
+package reputation
+
+import (
+	"sort"
+	"testing"
+)
+
+// manualClock is a Clock the test advances by hand.
+type manualClock struct{ now int }
+
+func (c *manualClock) Now() int { return c.now }
+
+// newTestDAO mints a token for each agent at the given virtue score (which
+// must clear the mint threshold) and wires a DAO with a manual clock.
+func newTestDAO(t *testing.T, quorum float64, scores map[string]int) (*DAOContract, *ReputationContract, *manualClock) {
+	t.Helper()
+	rep := NewReputationContract()
+	for agentID, score := range scores {
+		if !rep.MintToken(agentID, score) {
+			t.Fatalf("MintToken(%q, %d) refused", agentID, score)
+		}
+	}
+	clock := &manualClock{}
+	dao := NewDAOContract(rep, quorum)
+	dao.SetClock(clock)
+	return dao, rep, clock
+}
+
+// stubSource is a minimal ReputationSource backed by a plain map. Stakes can
+// be refused outright to exercise the DAO's failure paths.
+type stubSource struct {
+	reputations  map[string]int
+	refuseStakes bool
+}
+
+func (s *stubSource) GetReputation(agentID string) int { return s.reputations[agentID] }
+
+func (s *stubSource) TotalReputation() int {
+	total := 0
+	for _, rep := range s.reputations {
+		total += rep
+	}
+	return total
+}
+
+func (s *stubSource) RoleFor(agentID string) Role {
+	if s.reputations[agentID] >= 30 {
+		return RoleMember
+	}
+	return RoleObserver
+}
+
+func (s *stubSource) Agents() []string {
+	agents := make([]string, 0, len(s.reputations))
+	for agentID := range s.reputations {
+		agents = append(agents, agentID)
+	}
+	return agents
+}
+
+func (s *stubSource) IterateByReputation(desc bool, fn func(string, int) bool) {
+	agents := s.Agents()
+	sort.Slice(agents, func(i, j int) bool {
+		a, b := s.reputations[agents[i]], s.reputations[agents[j]]
+		if a != b {
+			return (a < b) != desc
+		}
+		return (agents[i] < agents[j]) != desc
+	})
+	for _, agentID := range agents {
+		if !fn(agentID, s.reputations[agentID]) {
+			return
+		}
+	}
+}
+
+func (s *stubSource) EligibleElectorate(bool) (int, int) {
+	return len(s.reputations), s.TotalReputation()
+}
+
+func (s *stubSource) HasToken(agentID string) bool { return s.reputations[agentID] > 0 }
+func (s *stubSource) PrimaryDomain(string) string  { return "" }
+func (s *stubSource) IsQuarantined(string) bool    { return false }
+
+func (s *stubSource) Reward(agentID string, amount int) int {
+	s.reputations[agentID] += amount
+	return s.reputations[agentID]
+}
+
+func (s *stubSource) Slash(agentID string, amount int, _ string) int {
+	s.reputations[agentID] -= min(amount, s.reputations[agentID])
+	return s.reputations[agentID]
+}
+
+func (s *stubSource) LockStake(agentID string, amount int) bool {
+	if s.refuseStakes || s.reputations[agentID] < amount {
+		return false
+	}
+	s.reputations[agentID] -= amount
+	return true
+}
+
+func (s *stubSource) SettleStake(agentID string, amount int, forfeit bool) {
+	if !forfeit {
+		s.reputations[agentID] += amount
+	}
+}
@@ -0,0 +1,22 @@
+package reputation
+
+import "testing"
+
+func TestProposeRuleIdempotent(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.6, map[string]int{"f": 81, "g": 81})
+	id, created := dao.ProposeRuleIdempotent("hello world!", "f")
+	if !created || id == "" {
+		t.Fatalf("first call: %q, %v", id, created)
+	}
+	retry, created := dao.ProposeRuleIdempotent("hello world!", "f")
+	if created || retry != id {
+		t.Fatalf("retry: %q, %v; want %q, false", retry, created, id)
+	}
+	if n := len(dao.GetAllProposals()); n != 1 {
+		t.Fatalf("%d proposals after a retry, want 1", n)
+	}
+	// The same text from another proposer is a different proposal
+	if other, created := dao.ProposeRuleIdempotent("hello world!", "g"); !created || other == id {
+		t.Fatalf("other proposer: %q, %v", other, created)
+	}
+}
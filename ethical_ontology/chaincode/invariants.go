@@ -0,0 +1,65 @@
+package reputation
+
+import (
+	"fmt"
+	"sort"
+)
+
+// VerifyInvariants checks every proposal's internal consistency and returns
+// each violation found, ordered by proposal ID; nil means the state is sound.
+// It is a debugging aid and changes nothing.
+func (d *DAOContract) VerifyInvariants() []error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	ids := make([]string, 0, len(d.proposals))
+	for id := range d.proposals {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var violations []error
+	violate := func(id string, format string, args ...any) {
+		violations = append(violations, fmt.Errorf("%w: proposal %q: %s", ErrInvariantViolation, id, fmt.Sprintf(format, args...)))
+	}
+	for _, id := range ids {
+		prop := d.proposals[id]
+		var forWeight, againstWeight, abstainWeight int64
+		for _, agentID := range prop.sortedVoters() {
+			ballot := prop.Ballots[agentID]
+			switch ballot.Choice {
+			case VoteFor:
+				forWeight += fixedQuadraticWeight(ballot.Weight, ballot.Reputation)
+			case VoteAgainst:
+				againstWeight += fixedQuadraticWeight(ballot.Weight, ballot.Reputation)
+			case VoteAbstain:
+				abstainWeight += fixedQuadraticWeight(ballot.Weight, ballot.Reputation)
+			}
+			if !prop.Voters[agentID] {
+				violate(id, "ballot from %q without a voter entry", agentID)
+			}
+		}
+		for agentID := range prop.Voters {
+			if _, ok := prop.Ballots[agentID]; !ok {
+				violate(id, "voter %q has no ballot", agentID)
+			}
+		}
+		if prop.ForWeight != forWeight || prop.AgainstWeight != againstWeight || prop.AbstainWeight != abstainWeight {
+			violate(id, "tally %d/%d/%d does not match ballots %d/%d/%d",
+				prop.ForWeight, prop.AgainstWeight, prop.AbstainWeight, forWeight, againstWeight, abstainWeight)
+		}
+		if prop.VotesFor != weightToFloat(prop.ForWeight) || prop.VotesAgainst != weightToFloat(prop.AgainstWeight) ||
+			prop.VotesAbstain != weightToFloat(prop.AbstainWeight) {
+			violate(id, "vote totals out of sync with fixed-point tally")
+		}
+		if prop.ForWeight < 0 || prop.AgainstWeight < 0 || prop.AbstainWeight < 0 ||
+			prop.VotesFor < 0 || prop.VotesAgainst < 0 || prop.VotesAbstain < 0 {
+			violate(id, "negative tally")
+		}
+		if prop.Active != (prop.Status == StatusActive || prop.Status == StatusTallying) {
+			violate(id, "status %s but active=%t", prop.Status, prop.Active)
+		}
+		if !prop.Active && prop.Stake > 0 {
+			violate(id, "decided with %d reputation still staked", prop.Stake)
+		}
+	}
+	return violations
+}
@@ -0,0 +1,34 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVerifyInvariants(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90})
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.ProposeRule("q", "b valid description", "f")
+	dao.Vote("p", "f", true, 1)
+	dao.Vote("q", "g", false, 1)
+	dao.Enact("p")
+	if violations := dao.VerifyInvariants(); violations != nil {
+		t.Fatalf("sound state reported %v", violations)
+	}
+	p := dao.GetProposal("p")
+	p.VotesFor = 99
+	p.Voters["ghost"] = true
+	p.Active = true
+	dao.GetProposal("q").AgainstWeight = -1
+	// p: a ghost voter, stale float totals, and passed yet active;
+	// q: a tally that matches neither its ballots nor itself, and is negative
+	violations := dao.VerifyInvariants()
+	if len(violations) != 6 {
+		t.Fatalf("%d violations, want 6: %v", len(violations), violations)
+	}
+	for _, v := range violations {
+		if !errors.Is(v, ErrInvariantViolation) {
+			t.Fatalf("violation %v does not wrap ErrInvariantViolation", v)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package reputation
+
+import "strconv"
+
+type ProposalStatus int
+
+const (
+	StatusActive ProposalStatus = iota
+	StatusPassed
+	StatusRejected         // voting closed with enough turnout but insufficient approval
+	StatusExpired          // voting closed without enough turnout to decide
+	StatusDisputed         // enacted but challenged, pending admin review
+	StatusTallying         // voting closed by CloseVoting, outcome pending Enact
+	StatusAwaitingSponsors // multisig proposal not yet opened by its co-signers
+)
+
+func (s ProposalStatus) String() string {
+	switch s {
+	case StatusActive:
+		return "active"
+	case StatusPassed:
+		return "passed"
+	case StatusRejected:
+		return "rejected"
+	case StatusExpired:
+		return "expired"
+	case StatusDisputed:
+		return "disputed"
+	case StatusTallying:
+		return "tallying"
+	case StatusAwaitingSponsors:
+		return "awaiting-sponsors"
+	default:
+		return "unknown"
+	}
+}
+
+// SetVotingPeriod gives new proposals a deadline this many seconds after
+// creation; zero leaves proposals open until enacted.
+func (d *DAOContract) SetVotingPeriod(seconds int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetVotingPeriod", seconds)
+	d.votingPeriod = seconds
+}
+
+// setStatus keeps the legacy Active flag in step with Status. A tallying
+// proposal is still undecided, so it stays active.
+func (p *Proposal) setStatus(status ProposalStatus) {
+	p.Status = status
+	p.Active = status == StatusActive || status == StatusTallying
+	if status != StatusTallying {
+		p.ClosedTally = nil
+	}
+}
+
+func (p *Proposal) votingClosed(now int) bool {
+	return p.Status == StatusTallying || (p.Deadline > 0 && now >= p.Deadline)
+}
+
+// finalizeFailed closes a proposal whose deadline passed without it passing,
+// or that can no longer pass, separating a genuine "no" from a lack of participation.
+func (d *DAOContract) finalizeFailed(prop *Proposal, results ProposalResults) {
+	status, eventType := StatusExpired, EventProposalExpired
+	if results.TurnoutMet {
+		status, eventType = StatusRejected, EventProposalRejected
+	}
+	prop.setStatus(status)
+	d.emitOutcome(prop, Event{
+		Type:    eventType,
+		Subject: prop.ID,
+		Details: map[string]string{"reason": results.Reason},
+	})
+	if status == StatusRejected {
+		d.rewardWinningSide(prop, VoteAgainst)
+	}
+}
+
+// ReopenProposal revives an expired proposal with a fresh deadline, keeping
+// the votes already cast. Decided proposals and reputation challenges cannot
+// be reopened.
+func (d *DAOContract) ReopenProposal(proposalID string, adminID string, newDeadline int) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("ReopenProposal", proposalID, adminID, newDeadline)
+	prop, exists := d.lookup(proposalID)
+	if !exists || prop.Status != StatusExpired || !d.admins[adminID] {
+		return false
+	}
+	// An expired challenge has already returned its stake, so reviving it
+	// would leave nothing to forfeit
+	if prop.ChallengeTarget != "" {
+		return false
+	}
+	if newDeadline <= d.clock.Now() {
+		return false
+	}
+	prop.setStatus(StatusActive)
+	prop.Deadline = newDeadline
+	d.emit(Event{
+		Type:    EventProposalReopened,
+		Subject: prop.ID,
+		Actor:   adminID,
+		Details: map[string]string{"deadline": strconv.Itoa(newDeadline)},
+	})
+	return true
+}
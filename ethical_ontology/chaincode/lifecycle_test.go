@@ -0,0 +1,37 @@
+package reputation
+
+import "testing"
+
+func TestReopenExpiredProposal(t *testing.T) {
+	dao, _, clock := newTestDAO(t, 0.6, map[string]int{"f": 81, "g": 81})
+	clock.now = 100
+	dao.AddAdmin("root")
+	dao.SetVotingPeriod(50)
+	dao.SetMinVoters(2)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.ProposeRule("q", "another valid description", "g")
+	dao.Vote("p", "f", true, 1)
+	dao.Vote("q", "f", false, 1)
+	dao.Vote("q", "g", false, 1)
+	clock.now = 150
+	if dao.Vote("p", "g", true, 1) {
+		t.Fatal("voted after the deadline")
+	}
+	dao.Enact("p")
+	dao.Enact("q")
+	if p, q := dao.GetProposal("p").Status, dao.GetProposal("q").Status; p != StatusExpired || q != StatusRejected {
+		t.Fatalf("statuses %v and %v, want expired and rejected", p, q)
+	}
+	if dao.ReopenProposal("q", "root", 300) {
+		t.Fatal("reopened a rejected proposal")
+	}
+	if dao.ReopenProposal("p", "f", 300) {
+		t.Fatal("non-admin reopened a proposal")
+	}
+	if !dao.ReopenProposal("p", "root", 300) || !dao.Vote("p", "g", true, 1) || !dao.Enact("p") {
+		t.Fatal("reopened proposal could not be voted through")
+	}
+	if voters := len(dao.GetProposal("p").Voters); voters != 2 {
+		t.Fatalf("reopening dropped earlier ballots: %d voters", voters)
+	}
+}
@@ -0,0 +1,33 @@
+package reputation
+
+// Logger receives structured operator logs: an event name followed by
+// alternating key/value pairs. Unlike the audit event log it is not part of
+// contract state.
+type Logger interface {
+	Info(event string, kv ...any)
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Info(string, ...any) {}
+
+func orNop(logger Logger) Logger {
+	if logger == nil {
+		return nopLogger{}
+	}
+	return logger
+}
+
+// SetLogger attaches logger to the contract; nil silences it.
+func (d *DAOContract) SetLogger(logger Logger) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.logger = orNop(logger)
+}
+
+// SetLogger attaches logger to the contract; nil silences it.
+func (c *ReputationContract) SetLogger(logger Logger) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logger = orNop(logger)
+}
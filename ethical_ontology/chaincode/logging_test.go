@@ -0,0 +1,48 @@
+package reputation
+
+import "testing"
+
+// recordingLogger keeps the event names it receives.
+type recordingLogger struct{ events []string }
+
+func (l *recordingLogger) Info(event string, kv ...any) {
+	if len(kv)%2 != 0 {
+		panic("odd number of key/value arguments for " + event)
+	}
+	l.events = append(l.events, event)
+}
+
+func TestLoggerReceivesContractEvents(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	logger := &recordingLogger{}
+	dao.SetLogger(logger)
+	rep.SetLogger(logger)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "f", true, 1)
+	dao.Enact("p")
+	rep.RevokeToken("f")
+	want := []string{"proposal_created", "vote_cast", "proposal_enacted", "token_revoked"}
+	if len(logger.events) != len(want) {
+		t.Fatalf("logged %v, want %v", logger.events, want)
+	}
+	for i := range want {
+		if logger.events[i] != want[i] {
+			t.Fatalf("logged %v, want %v", logger.events, want)
+		}
+	}
+}
+
+func TestNilLoggerIsSilent(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	dao.SetLogger(nil)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "f", true, 1)
+	dao.Enact("p")
+	// A contract loaded into a zero value gets a logger too
+	blob, _ := rep.MarshalJSON()
+	var loaded ReputationContract
+	if err := loaded.UnmarshalJSON(blob); err != nil {
+		t.Fatal(err)
+	}
+	loaded.Slash("f", 1, "x")
+}
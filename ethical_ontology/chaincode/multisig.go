@@ -0,0 +1,102 @@
+package reputation
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// SponsorRules set what a multisig proposal needs from its co-signers
+// before it opens for voting. Every co-signer counts toward Cosigners, but
+// activation also demands their combined reputation and, optionally, that
+// their primary expertise domains be distinct, so a tight clique cannot
+// sponsor proposals on its own.
+type SponsorRules struct {
+	Cosigners              int
+	MinSponsorReputation   int  // combined current reputation of the co-signers
+	RequireDistinctDomains bool // at least Cosigners different primary domains among them
+}
+
+func (d *DAOContract) SetSponsorRules(rules SponsorRules) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetSponsorRules", rules)
+	d.sponsorRules = rules
+}
+
+// ProposeMultisig creates a proposal that awaits co-signers under the
+// sponsor rules and opens for voting once they are satisfied. With no
+// co-signers required it opens at once, like ProposeRuleChecked.
+func (d *DAOContract) ProposeMultisig(id string, description string, proposerID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("ProposeMultisig", id, description, proposerID)
+	prop, err := d.propose(id, "", description, proposerID)
+	if err != nil {
+		return err
+	}
+	prop.setStatus(StatusAwaitingSponsors)
+	d.activateIfSponsored(prop)
+	return nil
+}
+
+// CoSign adds agentID, who must be allowed to propose, as a sponsor of a
+// multisig proposal awaiting co-signers.
+func (d *DAOContract) CoSign(proposalID string, agentID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("CoSign", proposalID, agentID)
+	prop, exists := d.lookup(proposalID)
+	if !exists || prop.Status != StatusAwaitingSponsors || agentID == prop.ProposerID {
+		return false
+	}
+	if slices.Contains(prop.Cosigners, agentID) || !d.hasRole(agentID, ActionPropose) || d.reputation.IsQuarantined(agentID) {
+		return false
+	}
+	prop.Cosigners = append(prop.Cosigners, agentID)
+	sort.Strings(prop.Cosigners)
+	d.emit(Event{Type: EventProposalCosigned, Subject: prop.ID, Actor: agentID})
+	d.activateIfSponsored(prop)
+	return true
+}
+
+// sponsorShortfall explains why prop's co-signers don't yet satisfy the
+// sponsor rules, or returns "" if they do.
+func (d *DAOContract) sponsorShortfall(prop *Proposal) string {
+	rules := d.sponsorRules
+	if len(prop.Cosigners) < rules.Cosigners {
+		return strconv.Itoa(len(prop.Cosigners)) + " of " + strconv.Itoa(rules.Cosigners) + " co-signers"
+	}
+	combined := 0
+	domains := make(map[string]bool)
+	for _, agentID := range prop.Cosigners {
+		combined += d.reputation.GetReputation(agentID)
+		if domain := d.reputation.PrimaryDomain(agentID); domain != "" {
+			domains[domain] = true
+		}
+	}
+	if combined < rules.MinSponsorReputation {
+		return "co-signer reputation " + strconv.Itoa(combined) + " below " + strconv.Itoa(rules.MinSponsorReputation)
+	}
+	if rules.RequireDistinctDomains && len(domains) < rules.Cosigners {
+		return strconv.Itoa(len(domains)) + " distinct co-signer domains, need " + strconv.Itoa(rules.Cosigners)
+	}
+	return ""
+}
+
+func (d *DAOContract) activateIfSponsored(prop *Proposal) {
+	if d.sponsorShortfall(prop) != "" {
+		return
+	}
+	now := d.clock.Now()
+	prop.setStatus(StatusActive)
+	// The voting period runs from activation, not from creation
+	if d.votingPeriod > 0 {
+		prop.Deadline = now + d.votingPeriod
+	}
+	d.emit(Event{
+		Type:    EventProposalActivated,
+		Subject: prop.ID,
+		Details: map[string]string{"cosigners": strconv.Itoa(len(prop.Cosigners))},
+	})
+}
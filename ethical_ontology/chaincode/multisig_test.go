@@ -0,0 +1,57 @@
+package reputation
+
+import "testing"
+
+func multisigDAO(t *testing.T) *DAOContract {
+	t.Helper()
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"p": 85, "x": 85, "y": 85, "z": 85})
+	rep.SetDomainScore("x", "care", 5)
+	rep.SetDomainScore("y", "care", 9)
+	rep.SetDomainScore("z", "justice", 3)
+	rep.SetDomainScore("z", "care", 1)
+	return dao
+}
+
+func TestCosignersMeetReputationMinimum(t *testing.T) {
+	dao := multisigDAO(t)
+	dao.SetSponsorRules(SponsorRules{Cosigners: 2, MinSponsorReputation: 200})
+	if err := dao.ProposeMultisig("m", "a valid description", "p"); err != nil {
+		t.Fatal(err)
+	}
+	if dao.Vote("m", "x", true, 1) {
+		t.Fatal("voted before sponsorship completed")
+	}
+	dao.CoSign("m", "x")
+	dao.CoSign("m", "y")
+	if dao.GetProposal("m").Status != StatusAwaitingSponsors {
+		t.Fatal("activated with 170 sponsor reputation, minimum 200")
+	}
+	dao.CoSign("m", "z")
+	if dao.GetProposal("m").Status != StatusActive {
+		t.Fatal("not activated at 255 sponsor reputation")
+	}
+}
+
+func TestCosignersFromDistinctDomains(t *testing.T) {
+	dao := multisigDAO(t)
+	dao.SetSponsorRules(SponsorRules{Cosigners: 2, RequireDistinctDomains: true})
+	dao.ProposeMultisig("n", "b valid description", "p")
+	dao.CoSign("n", "x")
+	dao.CoSign("n", "y")
+	if prop := dao.GetProposal("n"); prop.Status != StatusAwaitingSponsors || len(prop.Cosigners) != 2 {
+		t.Fatal("two care cosigners activated the proposal")
+	}
+	if dao.CoSign("n", "x") || dao.CoSign("n", "p") {
+		t.Fatal("repeat cosigner or proposer accepted")
+	}
+	dao.CoSign("n", "z") // primary domain is justice
+	if dao.GetProposal("n").Status != StatusActive || !dao.Vote("n", "x", true, 1) {
+		t.Fatal("a justice cosigner did not complete sponsorship")
+	}
+	if errs := dao.VerifyInvariants(); errs != nil {
+		t.Fatal(errs)
+	}
+	if _, _, err := ReplayOperations(dao.OperationLog()); err != nil {
+		t.Fatal(err)
+	}
+}
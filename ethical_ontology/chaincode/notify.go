@@ -0,0 +1,32 @@
+package reputation
+
+// NotificationSink delivers a proposal's outcome to one interested agent.
+// It is called with the contract locked and must not call back into it.
+type NotificationSink interface {
+	Notify(agentID string, event Event)
+}
+
+// SetNotificationSink sets where outcome notifications go; nil disables them.
+func (d *DAOContract) SetNotificationSink(sink NotificationSink) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.notifier = sink
+}
+
+// emitOutcome records and settles a proposal's final outcome, then notifies
+// its participants.
+func (d *DAOContract) emitOutcome(prop *Proposal, e Event) {
+	d.emit(e)
+	d.settleChallenge(prop, e.Type == EventProposalEnacted)
+	d.forfeitUnrevealed(prop)
+	if d.notifier == nil {
+		return
+	}
+	recipients := prop.sortedVoters()
+	if prop.ProposerID != "" && !prop.Voters[prop.ProposerID] {
+		recipients = append([]string{prop.ProposerID}, recipients...)
+	}
+	for _, agentID := range recipients {
+		d.notifier.Notify(agentID, e)
+	}
+}
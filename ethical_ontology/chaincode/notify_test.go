@@ -0,0 +1,31 @@
+package reputation
+
+import "testing"
+
+// recordingSink collects "type:subject" per notified agent.
+type recordingSink map[string][]string
+
+func (s recordingSink) Notify(agentID string, e Event) {
+	s[agentID] = append(s[agentID], e.Type+":"+e.Subject)
+}
+
+func TestOutcomeNotifiesProposerAndVoters(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90, "h": 90, "i": 90})
+	sink := recordingSink{}
+	dao.SetNotificationSink(sink)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "g", true, 1)
+	dao.Vote("p", "h", false, 1)
+	if len(sink) != 0 {
+		t.Fatalf("notified before the outcome: %v", sink)
+	}
+	dao.Enact("p")
+	if len(sink) != 3 {
+		t.Fatalf("notified %v, want the proposer and both voters", sink)
+	}
+	for _, agentID := range []string{"f", "g", "h"} {
+		if got := sink[agentID]; len(got) != 1 || got[0] != "proposal_enacted:p" {
+			t.Fatalf("%s received %v", agentID, got)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package reputation
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// Operation is one recorded state-changing call: the contract and method
+// invoked, the clock reading when it ran and its arguments as a JSON array.
+// Unlike events, which record outcomes, operations record inputs, so
+// ReplayOperations can rebuild state by running them again.
+type Operation struct {
+	Contract string // "dao" or "reputation"
+	Method   string
+	Time     int
+	Args     json.RawMessage
+}
+
+// opLog is shared by a ReputationContract and the DAO built on it, so the
+// two contracts' calls are recorded in one order.
+type opLog struct {
+	mu  sync.Mutex
+	ops []Operation
+}
+
+func (l *opLog) add(contract string, method string, now int, args []any) {
+	encoded, _ := json.Marshal(args)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.ops = append(l.ops, Operation{Contract: contract, Method: method, Time: now, Args: encoded})
+}
+
+func (l *opLog) snapshot() []Operation {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Operation(nil), l.ops...)
+}
+
+// record logs a call before it runs; the caller holds d.mu.
+func (d *DAOContract) record(method string, args ...any) {
+	d.ops.add("dao", method, d.clock.Now(), args)
+}
+
+// record logs a call before it runs; the caller holds c.mu.
+func (c *ReputationContract) record(method string, args ...any) {
+	c.ops.add("reputation", method, c.clock.Now(), args)
+}
+
+// OperationLog returns every state-changing call made so far, in order,
+// including calls on the reputation contract the DAO was built with.
+// Failed calls are recorded too, since replay must fail them the same way.
+func (d *DAOContract) OperationLog() []Operation {
+	return d.ops.snapshot()
+}
+
+// OperationLog returns every state-changing call made on this contract and
+// on any DAO built with it, in order.
+func (c *ReputationContract) OperationLog() []Operation {
+	return c.ops.snapshot()
+}
+
+// daoLedger is the DAO's view of a *ReputationContract. Rewards, slashes and
+// stakes moved on the DAO's behalf belong to the DAO operation that caused them,
+// so they are applied without being recorded a second time.
+type daoLedger struct {
+	*ReputationContract
+}
+
+func (l daoLedger) Reward(agentID string, amount int) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.reward(agentID, amount)
+}
+
+func (l daoLedger) Slash(agentID string, amount int, reason string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.slash(agentID, amount, reason)
+}
+
+func (l daoLedger) LockStake(agentID string, amount int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lockStake(agentID, amount)
+}
+
+func (l daoLedger) SettleStake(agentID string, amount int, forfeit bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.settleStake(agentID, amount, forfeit)
+}
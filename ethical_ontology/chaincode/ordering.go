@@ -0,0 +1,34 @@
+package reputation
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// ShuffledActiveProposals returns the active proposals in an order derived
+// only from seed, so every viewer with the same seed sees the same list.
+// Passing a per-epoch seed (e.g. a block hash) rotates the order each epoch.
+func (d *DAOContract) ShuffledActiveProposals(seed []byte) []*Proposal {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var active []*Proposal
+	for _, prop := range d.proposals {
+		if prop.Active {
+			active = append(active, prop)
+		}
+	}
+	// Start from a canonical order so map iteration can't leak into the result
+	sort.Slice(active, func(i, j int) bool { return active[i].ID < active[j].ID })
+	for i := len(active) - 1; i > 0; i-- {
+		j := int(d.seededIndex(seed, i) % uint64(i+1))
+		active[i], active[j] = active[j], active[i]
+	}
+	return active
+}
+
+// seededIndex derives the i-th pseudo-random value of a seed's stream.
+func (d *DAOContract) seededIndex(seed []byte, i int) uint64 {
+	msg := appendField(nil, seed)
+	msg = binary.BigEndian.AppendUint64(msg, uint64(i))
+	return binary.BigEndian.Uint64(d.digest(msg))
+}
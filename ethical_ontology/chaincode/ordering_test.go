@@ -0,0 +1,34 @@
+package reputation
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestShuffledActiveProposalsIsSeeded(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	for i := 0; i < 10; i++ {
+		dao.ProposeRule(fmt.Sprint("p", i), "a valid description", "f")
+	}
+	a := dao.ShuffledActiveProposals([]byte("epoch-1"))
+	b := dao.ShuffledActiveProposals([]byte("epoch-1"))
+	c := dao.ShuffledActiveProposals([]byte("epoch-2"))
+	if len(a) != 10 || len(c) != 10 {
+		t.Fatalf("shuffles have %d and %d proposals, want 10", len(a), len(c))
+	}
+	seen := make(map[string]bool)
+	reordered := false
+	for i := range a {
+		seen[a[i].ID] = true
+		if a[i].ID != b[i].ID {
+			t.Fatalf("same seed gave different orders at %d: %s vs %s", i, a[i].ID, b[i].ID)
+		}
+		reordered = reordered || a[i].ID != c[i].ID
+	}
+	if len(seen) != 10 {
+		t.Fatalf("shuffle repeated or dropped proposals: %v", seen)
+	}
+	if !reordered {
+		t.Fatal("different seeds gave the same order")
+	}
+}
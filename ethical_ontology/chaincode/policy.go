@@ -0,0 +1,127 @@
+package reputation
+
+import "fmt"
+
+type Outcome int
+
+const (
+	OutcomePass     Outcome = iota
+	OutcomeReject           // enough participation, not enough approval
+	OutcomeNoQuorum         // not enough participation to decide
+)
+
+// PolicyContext carries the computed tally and the contract-level thresholds
+// a policy may build on.
+type PolicyContext struct {
+	Results    ProposalResults
+	Quorum     float64
+	MinTurnout float64
+	MinVoters  int
+	// MinReputationShare is the fraction of the proposal's eligible
+	// reputation snapshot that must take part.
+	MinReputationShare float64
+}
+
+// EnactmentPolicy decides whether a proposal passes. Enact, WouldPassNow and
+// GetProposalResults all consult it, so they can never diverge.
+type EnactmentPolicy interface {
+	Evaluate(prop *Proposal, ctx PolicyContext) (Outcome, string)
+}
+
+// SimpleMajorityPolicy passes when approval reaches the contract quorum and
+// the contract's participation minimums are met. It is the default.
+type SimpleMajorityPolicy struct{}
+
+func (SimpleMajorityPolicy) Evaluate(prop *Proposal, ctx PolicyContext) (Outcome, string) {
+	if outcome, reason, ok := checkParticipation(ctx.Results, ctx.MinTurnout, ctx.MinVoters, ctx.MinReputationShare); !ok {
+		return outcome, reason
+	}
+	return checkApproval(ctx.Results, ctx.Quorum)
+}
+
+// SupermajorityPolicy replaces the contract quorum with a stricter Threshold.
+type SupermajorityPolicy struct {
+	Threshold float64
+}
+
+func (p SupermajorityPolicy) Evaluate(prop *Proposal, ctx PolicyContext) (Outcome, string) {
+	if outcome, reason, ok := checkParticipation(ctx.Results, ctx.MinTurnout, ctx.MinVoters, ctx.MinReputationShare); !ok {
+		return outcome, reason
+	}
+	return checkApproval(ctx.Results, max(p.Threshold, ctx.Quorum))
+}
+
+// ParticipationPolicy demands its own turnout and voter minimums, whichever
+// is stricter of it and the contract, before applying the contract quorum.
+type ParticipationPolicy struct {
+	MinTurnout float64
+	MinVoters  int
+}
+
+func (p ParticipationPolicy) Evaluate(prop *Proposal, ctx PolicyContext) (Outcome, string) {
+	minTurnout, minVoters := max(p.MinTurnout, ctx.MinTurnout), max(p.MinVoters, ctx.MinVoters)
+	if outcome, reason, ok := checkParticipation(ctx.Results, minTurnout, minVoters, ctx.MinReputationShare); !ok {
+		return outcome, reason
+	}
+	return checkApproval(ctx.Results, ctx.Quorum)
+}
+
+func checkParticipation(r ProposalResults, minTurnout float64, minVoters int, minShare float64) (Outcome, string, bool) {
+	switch {
+	case r.Turnout <= 0 || r.VotesFor+r.VotesAgainst <= 0:
+		return OutcomeNoQuorum, "no qualifying votes cast", false
+	case r.Turnout < minTurnout:
+		return OutcomeNoQuorum, fmt.Sprintf("turnout %.2f below minimum %.2f", r.Turnout, minTurnout), false
+	case r.Voters < minVoters:
+		return OutcomeNoQuorum, fmt.Sprintf("%d voters below minimum %d", r.Voters, minVoters), false
+	case r.ReputationShare < minShare:
+		return OutcomeNoQuorum, fmt.Sprintf("reputation share %.2f below minimum %.2f", r.ReputationShare, minShare), false
+	}
+	return OutcomePass, "", true
+}
+
+func checkApproval(r ProposalResults, threshold float64) (Outcome, string) {
+	if r.Approval < threshold {
+		return OutcomeReject, fmt.Sprintf("approval %.2f below threshold %.2f", r.Approval, threshold)
+	}
+	return OutcomePass, fmt.Sprintf("approval %.2f meets threshold %.2f", r.Approval, threshold)
+}
+
+// SetEnactmentPolicy sets the policy for proposals without one of their own.
+func (d *DAOContract) SetEnactmentPolicy(policy EnactmentPolicy) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.policy = policy
+}
+
+// RegisterPolicy makes a policy selectable per proposal by name.
+func (d *DAOContract) RegisterPolicy(name string, policy EnactmentPolicy) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("RegisterPolicy", name)
+	d.policies[name] = policy
+}
+
+// SetProposalPolicy assigns a registered policy to an active proposal; an
+// empty name reverts it to the contract default.
+func (d *DAOContract) SetProposalPolicy(proposalID string, name string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetProposalPolicy", proposalID, name)
+	prop, exists := d.lookup(proposalID)
+	if !exists || !prop.Active {
+		return false
+	}
+	if _, registered := d.policies[name]; name != "" && !registered {
+		return false
+	}
+	prop.PolicyName = name
+	return true
+}
+
+func (d *DAOContract) policyFor(prop *Proposal) EnactmentPolicy {
+	if policy, ok := d.policies[prop.PolicyName]; ok && prop.PolicyName != "" {
+		return policy
+	}
+	return d.policy
+}
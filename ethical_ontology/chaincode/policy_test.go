@@ -0,0 +1,49 @@
+package reputation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEnactmentPolicies(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 81, "h": 81})
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "f", true, 1)
+	dao.Vote("p", "g", true, 1)
+	dao.Vote("p", "h", false, 1)
+	if pass, reason := dao.WouldPassNow("p"); !pass {
+		t.Fatalf("two thirds under simple majority: %s", reason)
+	}
+	dao.RegisterPolicy("super", SupermajorityPolicy{Threshold: 0.75})
+	if !dao.SetProposalPolicy("p", "super") {
+		t.Fatal("SetProposalPolicy refused a registered policy")
+	}
+	if pass, _ := dao.WouldPassNow("p"); pass {
+		t.Fatal("two thirds passed a three-quarters supermajority")
+	}
+	dao.SetProposalPolicy("p", "")
+	dao.SetEnactmentPolicy(ParticipationPolicy{MinVoters: 4})
+	if pass, reason := dao.WouldPassNow("p"); pass {
+		t.Fatalf("three voters passed a four-voter default policy: %s", reason)
+	}
+	dao.SetEnactmentPolicy(SimpleMajorityPolicy{})
+	if !dao.Enact("p") {
+		t.Fatal("Enact refused under simple majority")
+	}
+}
+
+func TestReplayRefusesRegisteredPolicies(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.SetProposalPolicy("p", "unknown") // refused live, and again on replay
+	if _, _, err := ReplayOperations(dao.OperationLog()); err != nil {
+		t.Fatalf("replaying a refused assignment: %v", err)
+	}
+	dao.RegisterPolicy("super", SupermajorityPolicy{Threshold: 0.75})
+	dao.SetProposalPolicy("p", "super")
+	_, _, err := ReplayOperations(dao.OperationLog())
+	if !errors.Is(err, ErrInvalidOperation) || !strings.Contains(err.Error(), `"super"`) {
+		t.Fatalf("got %v, want ErrInvalidOperation naming the policy", err)
+	}
+}
@@ -0,0 +1,42 @@
+package reputation
+
+// VotingPower breaks down what an agent could cast on a proposal right now.
+type VotingPower struct {
+	Reputation     int // the agent's own reputation, less any split delegations
+	DelegatedPower int // reputation received from delegators who haven't voted directly
+	// EpochRemaining is the quadratic weight left under the per-epoch cap;
+	// it and MaxWeight are only meaningful when EpochCapped is set.
+	EpochCapped    bool
+	EpochRemaining float64
+	MaxWeight      int // largest vote weight the epoch cap still allows
+	CanVote        bool
+	Reason         string // why CanVote is false
+}
+
+// EffectiveVotingPower reports agentID's current clout on proposalID,
+// applying the same eligibility checks as Vote. It changes no state.
+func (d *DAOContract) EffectiveVotingPower(agentID string, proposalID string) VotingPower {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	power := VotingPower{Reputation: d.ownReputation(agentID)}
+	prop, err := d.activeProposal(proposalID)
+	if err == nil {
+		now := d.clock.Now()
+		power.DelegatedPower = d.delegatedPower(agentID, prop)
+		if d.epochCapped() {
+			remaining := max(d.maxWeightPerEpoch-d.epochWeightUsed(agentID, now), 0)
+			power.EpochCapped = true
+			power.EpochRemaining = weightToFloat(remaining)
+			if unit := fixedQuadraticWeight(1, power.Reputation+power.DelegatedPower); unit > 0 {
+				power.MaxWeight = int(remaining / unit)
+			}
+		}
+		err = d.voteBlocker(prop, agentID, now)
+	}
+	if err != nil {
+		power.Reason = err.Error()
+		return power
+	}
+	power.CanVote = true
+	return power
+}
@@ -0,0 +1,26 @@
+package reputation
+
+import "testing"
+
+func TestEffectiveVotingPower(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90, "q": 90})
+	dao.SetEpochWeightCap(100, 1000)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.ProposeRule("p2", "a valid description 2", "f")
+	dao.Delegate("g", "f")
+	dao.Vote("p2", "f", true, 2) // 2 * sqrt(81 + 90), about 26.15
+	power := dao.EffectiveVotingPower("f", "p")
+	if !power.CanVote || power.Reputation != 81 || power.DelegatedPower != 90 {
+		t.Fatalf("delegate's power = %+v", power)
+	}
+	if !power.EpochCapped || power.MaxWeight != 5 {
+		t.Fatalf("epoch headroom = %+v, want MaxWeight 5", power)
+	}
+	rep.Quarantine("q")
+	if power := dao.EffectiveVotingPower("q", "p"); power.CanVote || power.Reason == "" {
+		t.Fatalf("quarantined agent: %+v", power)
+	}
+	if power := dao.EffectiveVotingPower("g", "p"); power.CanVote {
+		t.Fatalf("delegator: %+v", power)
+	}
+}
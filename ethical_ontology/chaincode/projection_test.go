@@ -0,0 +1,61 @@
+package reputation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWouldPassNowMatchesEnact(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.6, map[string]int{"f": 81, "g": 81})
+	dao.SetMinVoters(2)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "f", true, 1)
+	if pass, reason := dao.WouldPassNow("p"); pass || reason == "" {
+		t.Fatalf("one voter of two required: %v, %q", pass, reason)
+	}
+	if dao.Enact("p") {
+		t.Fatal("Enact disagreed with WouldPassNow")
+	}
+	dao.Vote("p", "g", true, 1)
+	if pass, reason := dao.WouldPassNow("p"); !pass {
+		t.Fatalf("both voted for: %s", reason)
+	}
+	if !dao.Enact("p") {
+		t.Fatal("Enact disagreed with WouldPassNow")
+	}
+	if pass, _ := dao.WouldPassNow("p"); pass {
+		t.Fatal("an enacted proposal cannot pass again")
+	}
+}
+
+func TestWouldPassNowWaitsForReveals(t *testing.T) {
+	dao, _, clock := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90})
+	clock.now = 100
+	dao.SetVotingPeriod(50)
+	dao.SetRevealWindow(20)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.CommitVote("p", "f", dao.VoteCommitment("p", "f", VoteAgainst, 1, []byte("s")))
+	dao.Vote("p", "g", true, 1)
+	if pass, _ := dao.WouldPassNow("p"); pass {
+		t.Fatal("WouldPassNow passed a proposal with reveals outstanding")
+	}
+	if err := dao.EnactChecked("p"); !errors.Is(err, ErrRevealPending) {
+		t.Fatalf("got %v, want ErrRevealPending", err)
+	}
+}
+
+func TestWouldPassNowValidatesEffects(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 100, "b": 90})
+	dao.ProposeWithEffects("p", "a valid description", "a", []Effect{SlashEffect("b", 60)})
+	dao.ProposeWithEffects("q", "b valid description", "a", []Effect{SlashEffect("b", 40)})
+	dao.Vote("p", "a", true, 1)
+	dao.Vote("q", "a", true, 1)
+	dao.Enact("p")
+	if pass, reason := dao.WouldPassNow("q"); pass || !strings.Contains(reason, "cannot afford") {
+		t.Fatalf("WouldPassNow = %v, %q for an effect that no longer validates", pass, reason)
+	}
+	if err := dao.EnactChecked("q"); !errors.Is(err, ErrInvalidEffect) {
+		t.Fatalf("got %v, want ErrInvalidEffect", err)
+	}
+}
@@ -0,0 +1,56 @@
+package reputation
+
+import (
+	"strings"
+	"unicode"
+)
+
+// IDRules governs how user-supplied proposal IDs are normalized. The same
+// rules apply when a proposal is created and whenever it is looked up, so
+// " Prop1 " and "prop1" reach the same proposal when CaseFold is set.
+type IDRules struct {
+	Trim               bool
+	CaseFold           bool
+	RejectControlChars bool
+}
+
+var DefaultIDRules = IDRules{Trim: true, RejectControlChars: true}
+
+// SetIDRules should be called before any proposal exists; changing the rules
+// afterwards can strand IDs created under the old ones.
+func (d *DAOContract) SetIDRules(rules IDRules) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetIDRules", rules)
+	d.idRules = rules
+}
+
+// canonical applies the rules' transformations without validating.
+func (r IDRules) canonical(id string) string {
+	if r.Trim {
+		id = strings.TrimSpace(id)
+	}
+	if r.CaseFold {
+		id = strings.ToLower(id)
+	}
+	return id
+}
+
+// normalize returns the canonical form of a new proposal ID, or
+// ErrInvalidProposalID if nothing usable is left.
+func (r IDRules) normalize(id string) (string, error) {
+	id = r.canonical(id)
+	if strings.TrimSpace(id) == "" {
+		return "", ErrInvalidProposalID
+	}
+	if r.RejectControlChars && strings.IndexFunc(id, unicode.IsControl) >= 0 {
+		return "", ErrInvalidProposalID
+	}
+	return id, nil
+}
+
+// lookup finds a proposal by a user-supplied ID.
+func (d *DAOContract) lookup(id string) (*Proposal, bool) {
+	prop, exists := d.proposals[d.idRules.canonical(id)]
+	return prop, exists
+}
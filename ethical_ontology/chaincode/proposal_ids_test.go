@@ -0,0 +1,100 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProposalIDSanitization(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	for _, id := range []string{"", "  \t ", "a\x00b"} {
+		if err := dao.ProposeRuleChecked(id, "a valid description", "f"); !errors.Is(err, ErrInvalidProposalID) {
+			t.Fatalf("ID %q: got %v, want ErrInvalidProposalID", id, err)
+		}
+	}
+	dao.ProposeRule(" Prop1 ", "a valid description", "f")
+	if dao.GetProposal("Prop1") == nil {
+		t.Fatal("surrounding whitespace was not trimmed")
+	}
+	if dao.GetProposal("prop1") != nil {
+		t.Fatal("IDs are case-sensitive by default")
+	}
+}
+
+func TestCaseFoldedIDsResolveEverywhere(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	dao.SetIDRules(IDRules{Trim: true, CaseFold: true, RejectControlChars: true})
+	dao.ProposeRule("Prop2", "b valid description", "f")
+	if dao.ProposeRule("PROP2 ", "c valid description", "f") {
+		t.Fatal("an ID differing only by case created a second proposal")
+	}
+	if !dao.Vote(" pRoP2", "f", true, 1) || !dao.Enact("PROP2") {
+		t.Fatal("variant spellings did not reach the proposal")
+	}
+	if dao.GetProposal("prop2").Status != StatusPassed {
+		t.Fatal("proposal not enacted")
+	}
+	if id := dao.BallotHistory("f")[0].ProposalID; id != "prop2" {
+		t.Fatalf("ballot recorded against %q, want the canonical ID", id)
+	}
+}
+
+func TestEventsNameTheCanonicalID(t *testing.T) {
+	dao, _, clock := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 82, "h": 83, "root": 90})
+	clock.now = 100
+	dao.AddAdmin("root")
+	dao.SetVotingPeriod(50)
+	dao.SetBreaker(BreakerConfig{MaxVotes: 1, Window: 10})
+	dao.SetChallengeRules(ChallengeRules{Count: 1})
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.ProposeRule("q", "b valid description", "g")
+	dao.Vote("p", "f", true, 1)
+	dao.Vote("p", "g", true, 1) // trips the breaker
+	if !dao.ResumeVoting(" p ", "root") {
+		t.Fatal("ResumeVoting refused")
+	}
+	clock.now = 150
+	dao.Enact("p")
+	dao.Enact("q")
+	if !dao.ChallengeProposal(" p ", "h", "bad") {
+		t.Fatal("ChallengeProposal refused")
+	}
+	if !dao.ReopenProposal(" q ", "root", 300) {
+		t.Fatal("ReopenProposal refused")
+	}
+	if err := dao.ProposeRuleFastTrack(" ft ", "c valid description", "root"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		EventVotingResumed:       "p",
+		EventProposalChallenged:  "p",
+		EventProposalDisputed:    "p",
+		EventProposalReopened:    "q",
+		EventProposalFastTracked: "ft",
+	}
+	for _, e := range dao.Events() {
+		if id, tracked := want[e.Type]; tracked {
+			if e.Subject != id {
+				t.Fatalf("%v event names %q, want %q", e.Type, e.Subject, id)
+			}
+			delete(want, e.Type)
+		}
+	}
+	if len(want) != 0 {
+		t.Fatalf("events missing: %v", want)
+	}
+	// The audit trail filters on the canonical ID
+	for _, entry := range dao.AuditSample(10, []byte("seed")).Entries {
+		if entry.Proposal.ID != "p" {
+			continue
+		}
+		types := map[string]bool{}
+		for _, e := range entry.Events {
+			types[e.Type] = true
+		}
+		if !types[EventVotingResumed] || !types[EventProposalChallenged] {
+			t.Fatalf("p's audit trail misses resume or challenge events: %v", entry.Events)
+		}
+	}
+}
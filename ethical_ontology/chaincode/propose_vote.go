@@ -0,0 +1,65 @@
+package reputation
+
+// SetSelfVoting controls whether proposers may vote on their own proposals.
+// It is allowed by default.
+func (d *DAOContract) SetSelfVoting(allowed bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetSelfVoting", allowed)
+	d.selfVoteDisallowed = !allowed
+}
+
+// ProposeAndVote creates a proposal and casts the proposer's for-vote with
+// weight in one locked step. When self-voting is disallowed it only
+// proposes. If the vote is refused the proposal is withdrawn as if it had
+// never been made.
+func (d *DAOContract) ProposeAndVote(id string, description string, proposerID string, weight int) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("ProposeAndVote", id, description, proposerID, weight)
+	undo := d.proposeUndo(proposerID)
+	prop, err := d.propose(id, "", description, proposerID)
+	if err != nil {
+		return false
+	}
+	if d.selfVoteDisallowed {
+		return true
+	}
+	now := d.clock.Now()
+	err = d.voteBlocker(prop, proposerID, now)
+	if err == nil {
+		err = d.castVote(prop, proposerID, VoteFor, weight, "", now)
+	}
+	if err != nil {
+		d.withdraw(prop, undo)
+		return false
+	}
+	return true
+}
+
+// proposalUndo is what propose changes besides adding the proposal, saved
+// so a caller that fails after proposing can withdraw it cleanly.
+type proposalUndo struct {
+	proposerID   string
+	lastProposed int
+	hadProposed  bool
+	count        int
+	events       int
+}
+
+func (d *DAOContract) proposeUndo(proposerID string) proposalUndo {
+	lastProposed, hadProposed := d.lastProposed[proposerID]
+	return proposalUndo{proposerID, lastProposed, hadProposed, d.proposalCount, d.eventCount()}
+}
+
+// withdraw removes prop as if it had never been proposed.
+func (d *DAOContract) withdraw(prop *Proposal, undo proposalUndo) {
+	delete(d.proposals, prop.ID)
+	d.proposalCount = undo.count
+	d.discardEventsAfter(undo.events)
+	if undo.hadProposed {
+		d.lastProposed[undo.proposerID] = undo.lastProposed
+	} else {
+		delete(d.lastProposed, undo.proposerID)
+	}
+}
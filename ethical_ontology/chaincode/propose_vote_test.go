@@ -0,0 +1,29 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProposeAndVote(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	if !dao.ProposeAndVote("p", "a valid description", "f", 2) || dao.GetProposal("p").Ballots["f"].Weight != 2 {
+		t.Fatal("proposer's ballot not recorded")
+	}
+	// A refused ballot takes the proposal down with it
+	dao.SetEpochWeightCap(1, 100)
+	if dao.ProposeAndVote("q", "b valid description", "f", 5) || dao.GetProposal("q") != nil {
+		t.Fatal("proposal survived a refused ballot")
+	}
+}
+
+func TestProposeAndVoteWithoutSelfVoting(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	dao.SetSelfVoting(false)
+	if !dao.ProposeAndVote("q", "b valid description", "f", 5) || len(dao.GetProposal("q").Ballots) != 0 {
+		t.Fatal("want the proposal created with no ballot")
+	}
+	if err := dao.VoteChecked("q", "f", VoteFor, 1); !errors.Is(err, ErrSelfVote) {
+		t.Fatalf("got %v, want ErrSelfVote", err)
+	}
+}
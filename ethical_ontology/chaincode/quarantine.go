@@ -0,0 +1,33 @@
+package reputation
+
+// Quarantine freezes agentID's governance rights pending review without
+// touching their reputation. It reports false if already quarantined.
+func (c *ReputationContract) Quarantine(agentID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("Quarantine", agentID)
+	if c.quarantined[agentID] {
+		return false
+	}
+	c.quarantined[agentID] = true
+	c.emit(Event{Type: EventAgentQuarantined, Subject: agentID})
+	return true
+}
+
+func (c *ReputationContract) Unquarantine(agentID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("Unquarantine", agentID)
+	if !c.quarantined[agentID] {
+		return false
+	}
+	delete(c.quarantined, agentID)
+	c.emit(Event{Type: EventAgentReleased, Subject: agentID})
+	return true
+}
+
+func (c *ReputationContract) IsQuarantined(agentID string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.quarantined[agentID]
+}
@@ -0,0 +1,53 @@
+package reputation
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestQuarantineFreezesVotingPower(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 81})
+	dao.ProposeRule("p", "a valid description", "f")
+	rep.Quarantine("g")
+	if err := dao.VoteChecked("p", "g", VoteFor, 1); !errors.Is(err, ErrQuarantined) {
+		t.Fatalf("quarantined vote: got %v, want ErrQuarantined", err)
+	}
+	if dao.ProposeRule("q", "a valid description", "g") {
+		t.Fatal("quarantined agent proposed")
+	}
+	if err := dao.DelegateChecked("f", "g"); !errors.Is(err, ErrQuarantined) {
+		t.Fatalf("delegation to a quarantined agent: got %v, want ErrQuarantined", err)
+	}
+
+	blob, _ := json.Marshal(rep)
+	loaded := NewReputationContract()
+	if err := json.Unmarshal(blob, loaded); err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.AgentSummary("g").Quarantined || loaded.GetReputation("g") != 81 {
+		t.Fatal("quarantine or reputation lost in serialization")
+	}
+	rep.Unquarantine("g")
+	if err := dao.VoteChecked("p", "g", VoteFor, 1); err != nil {
+		t.Fatalf("vote after release: %v", err)
+	}
+}
+
+func TestQuarantinedDelegatorPowerDoesNotFlow(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90, "c": 90})
+	dao.ProposeRule("p", "a valid description", "c")
+	dao.Delegate("a", "b")
+	dao.DelegateSplit("c", map[string]float64{"b": 0.5})
+	rep.Quarantine("a")
+	rep.Quarantine("c")
+	if power := dao.EffectiveVotingPower("b", "p"); power.DelegatedPower != 0 {
+		t.Fatalf("delegated power %d from quarantined delegators", power.DelegatedPower)
+	}
+	if err := dao.VoteChecked("p", "b", VoteFor, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got := dao.GetProposal("p").Ballots["b"].Reputation; got != 90 {
+		t.Fatalf("ballot carries %d, want b's own 90", got)
+	}
+}
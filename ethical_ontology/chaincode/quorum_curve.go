@@ -0,0 +1,30 @@
+package reputation
+
+import "math"
+
+// QuorumCurve maps the size of the eligible electorate to the minimum
+// turnout a new proposal must reach. It is evaluated once, when the
+// proposal is created, and can only raise the contract's MinTurnout.
+type QuorumCurve func(members int, totalReputation int) float64
+
+// PowerQuorum requires base * members^exponent ballots of average
+// reputation. An exponent below one makes the required share of the
+// electorate shrink as it grows, so quorum stays reachable in large DAOs
+// without becoming trivial in small ones.
+func PowerQuorum(base float64, exponent float64) QuorumCurve {
+	return func(members int, totalReputation int) float64 {
+		if members <= 0 || totalReputation <= 0 {
+			return 0
+		}
+		averageWeight := math.Sqrt(float64(totalReputation) / float64(members))
+		return base * math.Pow(float64(members), exponent) * averageWeight
+	}
+}
+
+// SetQuorumCurve enables adaptive quorum for proposals created from now on;
+// nil turns it off. Existing proposals keep the turnout they were created with.
+func (d *DAOContract) SetQuorumCurve(curve QuorumCurve) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.quorumCurve = curve
+}
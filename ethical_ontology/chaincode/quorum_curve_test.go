@@ -0,0 +1,76 @@
+package reputation
+
+import (
+	"fmt"
+	"testing"
+)
+
+func curveDAO(t *testing.T, members int) *DAOContract {
+	t.Helper()
+	scores := map[string]int{}
+	for i := 0; i < members; i++ {
+		scores[fmt.Sprint("m", i)] = 90
+	}
+	dao, _, _ := newTestDAO(t, 0.5, scores)
+	dao.SetQuorumCurve(PowerQuorum(0.5, 0.5))
+	dao.ProposeRule("p", "a valid description", "m0")
+	return dao
+}
+
+func TestAdaptiveTurnoutScalesWithElectorate(t *testing.T) {
+	small, large := curveDAO(t, 2), curveDAO(t, 50)
+	s, l := small.GetProposal("p").AdaptiveTurnout, large.GetProposal("p").AdaptiveTurnout
+	if s <= 0 || s >= l {
+		t.Fatalf("turnout for 2 members %v, for 50 members %v", s, l)
+	}
+	large.Vote("p", "m0", true, 1)
+	if pass, reason := large.WouldPassNow("p"); pass {
+		t.Fatalf("one ballot met the large electorate's turnout: %s", reason)
+	}
+	// Turning the curve off later doesn't relax an existing proposal
+	large.SetQuorumCurve(nil)
+	large.Vote("p", "m1", true, 1)
+	large.Vote("p", "m2", true, 1)
+	if pass, _ := large.WouldPassNow("p"); pass {
+		t.Fatal("proposal ignored the turnout it was created with")
+	}
+	small.Vote("p", "m0", true, 1)
+	small.Vote("p", "m1", true, 1)
+	if pass, reason := small.WouldPassNow("p"); !pass {
+		t.Fatalf("small electorate fully voted: %s", reason)
+	}
+}
+
+func TestAdaptiveTurnoutNeverLowersMinTurnout(t *testing.T) {
+	dao := curveDAO(t, 2)
+	adaptive := dao.GetProposal("p").AdaptiveTurnout
+	dao.SetMinTurnout(adaptive * 10)
+	dao.Vote("p", "m0", true, 1)
+	dao.Vote("p", "m1", true, 1)
+	if pass, reason := dao.WouldPassNow("p"); pass {
+		t.Fatalf("adaptive turnout %v overrode the higher contract minimum: %s", adaptive, reason)
+	}
+}
+
+func TestAdaptiveTurnoutIgnoresQuarantinedMembers(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90, "whale": 100})
+	rep.SetReputationCap(0)
+	rep.Reward("whale", 2000)
+	dao.SetQuorumCurve(PowerQuorum(1, 1))
+	dao.ProposeRule("p", "a valid description", "a")
+	rep.Quarantine("whale")
+	dao.ProposeRule("q", "b valid description", "b")
+	if p, q := dao.GetProposal("p").EligibleReputation, dao.GetProposal("q").EligibleReputation; p != 2280 || q != 180 {
+		t.Fatalf("eligible reputation %d before quarantine, %d after; want 2280 and 180", p, q)
+	}
+	for _, id := range []string{"p", "q"} {
+		dao.Vote(id, "a", true, 3)
+		dao.Vote(id, "b", true, 3)
+	}
+	if res, _ := dao.GetProposalResults("p"); res.Passes {
+		t.Fatal("the whale's absence met a quorum sized to include it")
+	}
+	if res, _ := dao.GetProposalResults("q"); !res.Passes {
+		t.Fatal("a quarantined member still counted toward quorum")
+	}
+}
@@ -0,0 +1,51 @@
+package reputation
+
+import "math"
+
+// RecencyWeight scales a ballot's contribution to turnout by its age in
+// seconds. It only affects turnout, never the for/against tally.
+type RecencyWeight func(age int) float64
+
+// ExponentialDecay halves a ballot's turnout contribution every halfLife seconds.
+func ExponentialDecay(halfLife int) RecencyWeight {
+	return func(age int) float64 {
+		if halfLife <= 0 || age <= 0 {
+			return 1
+		}
+		return math.Pow(0.5, float64(age)/float64(halfLife))
+	}
+}
+
+// SetRecencyWeighting enables momentum-style turnout; nil turns it off.
+func (d *DAOContract) SetRecencyWeighting(weight RecencyWeight) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.recencyWeight = weight
+}
+
+// weightedTurnout sums turnout ballot by ballot, as needed when ballots are
+// weighted by age or some fall outside the quorum electorate.
+func (d *DAOContract) weightedTurnout(prop *Proposal) float64 {
+	now := d.clock.Now()
+	turnout := 0.0
+	for _, agentID := range prop.sortedVoters() {
+		ballot := prop.Ballots[agentID]
+		if !d.inElectorate(agentID) {
+			continue
+		}
+		voteWeight := weightToFloat(fixedQuadraticWeight(ballot.Weight, ballot.Reputation))
+		switch ballot.Choice {
+		case VoteAgainst:
+			voteWeight *= d.againstMultiplier
+		case VoteAbstain:
+			if !d.abstainCountsTowardQuorum {
+				continue
+			}
+		}
+		if d.recencyWeight != nil {
+			voteWeight *= d.recencyWeight(now - ballot.Time)
+		}
+		turnout += voteWeight
+	}
+	return turnout
+}
@@ -0,0 +1,24 @@
+package reputation
+
+import "testing"
+
+func TestRecencyWeightingDecaysTurnout(t *testing.T) {
+	dao, _, clock := newTestDAO(t, 0.6, map[string]int{"f": 81, "g": 81})
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "f", true, 1)
+	clock.now = 100
+	dao.Vote("p", "g", true, 1)
+	flat, _ := dao.GetProposalResults("p")
+	dao.SetRecencyWeighting(ExponentialDecay(100))
+	decayed, _ := dao.GetProposalResults("p")
+	if flat.Turnout != 18 {
+		t.Fatalf("unweighted turnout = %v, want 18", flat.Turnout)
+	}
+	// The ballot one half-life old counts half toward turnout
+	if decayed.Turnout != 13.5 {
+		t.Fatalf("decayed turnout = %v, want 13.5", decayed.Turnout)
+	}
+	if decayed.VotesFor != flat.VotesFor {
+		t.Fatalf("decay changed the approval tally: %v vs %v", decayed.VotesFor, flat.VotesFor)
+	}
+}
@@ -0,0 +1,488 @@
+package reputation
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+)
+
+// replayClock reports the recorded time of the operation being replayed.
+type replayClock struct {
+	now int
+}
+
+func (c *replayClock) Now() int {
+	return c.now
+}
+
+// ReplayOperations rebuilds contracts by re-executing ops, as returned by
+// OperationLog, in order against a fresh ReputationContract and the DAO the
+// log created on it (nil if it created none). Each call sees the time it
+// originally ran at. Wiring that cannot be recorded (clocks, hashers,
+// loggers, sinks, oracles, enactment policies and quorum curves) is left at
+// its defaults, so a session that changed it replays faithfully only if the
+// change did not affect state. A log that assigns a registered policy to a
+// proposal is refused, since only the policy's name was recorded. Sessions
+// driven from one goroutine replay exactly; concurrent calls are replayed in
+// the order they were recorded.
+func ReplayOperations(ops []Operation) (*DAOContract, *ReputationContract, error) {
+	clock := &replayClock{}
+	rep := NewReputationContract()
+	rep.SetClock(clock)
+	var dao *DAOContract
+	policies := make(map[string]bool) // names registered in the session
+	for i, op := range ops {
+		clock.now = op.Time
+		var args []json.RawMessage
+		err := json.Unmarshal(op.Args, &args)
+		switch {
+		case err != nil:
+		case op.Contract == "reputation":
+			err = replayCall(reputationReplayers, op.Method, rep, args)
+		case op.Contract != "dao":
+			err = fmt.Errorf("unknown contract %q", op.Contract)
+		case op.Method == "New":
+			if dao != nil {
+				err = fmt.Errorf("log creates more than one DAO")
+				break
+			}
+			var quorum float64
+			if err = decodeArgs(args, &quorum); err == nil {
+				dao = NewDAOContract(rep, quorum)
+				dao.SetClock(clock)
+			}
+		case dao == nil:
+			err = fmt.Errorf("no DAO has been created")
+		case op.Method == "RegisterPolicy":
+			var name string
+			if err = decodeArgs(args, &name); err == nil {
+				policies[name] = true
+			}
+		case op.Method == "SetProposalPolicy" && policies[policyName(args)]:
+			err = fmt.Errorf("enactment policy %q cannot be replayed", policyName(args))
+		default:
+			err = replayCall(daoReplayers, op.Method, dao, args)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: operation %d (%s.%s): %v", ErrInvalidOperation, i, op.Contract, op.Method, err)
+		}
+	}
+	return dao, rep, nil
+}
+
+func replayCall[C any](replayers map[string]func(C, []json.RawMessage) error, method string, contract C, args []json.RawMessage) error {
+	replayer, known := replayers[method]
+	if !known {
+		return fmt.Errorf("unknown method")
+	}
+	return replayer(contract, args)
+}
+
+// policyName is the name a recorded SetProposalPolicy call assigned, or ""
+// if its arguments don't decode.
+func policyName(args []json.RawMessage) string {
+	var proposalID, name string
+	if decodeArgs(args, &proposalID, &name) != nil {
+		return ""
+	}
+	return name
+}
+
+// decodeArgs unmarshals each recorded argument into the matching target.
+func decodeArgs(args []json.RawMessage, targets ...any) error {
+	if len(args) != len(targets) {
+		return fmt.Errorf("want %d arguments, got %d", len(targets), len(args))
+	}
+	for i, target := range targets {
+		if err := json.Unmarshal(args[i], target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// invoke decodes args into targets and then runs the replayed method. Return
+// values are dropped: a call that failed originally fails again.
+func invoke(args []json.RawMessage, run func(), targets ...any) error {
+	if err := decodeArgs(args, targets...); err != nil {
+		return err
+	}
+	run()
+	return nil
+}
+
+var reputationReplayers = map[string]func(*ReputationContract, []json.RawMessage) error{
+	"Decay": func(c *ReputationContract, args []json.RawMessage) error {
+		var fraction float64
+		return invoke(args, func() { c.Decay(fraction) }, &fraction)
+	},
+	"InitGenesis": func(c *ReputationContract, args []json.RawMessage) error {
+		var alloc map[string]int
+		var signature []byte
+		return invoke(args, func() { c.InitGenesis(alloc, signature) }, &alloc, &signature)
+	},
+	"LockStake": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID string
+		var amount int
+		return invoke(args, func() { c.LockStake(agentID, amount) }, &agentID, &amount)
+	},
+	"MergeAccounts": func(c *ReputationContract, args []json.RawMessage) error {
+		var fromID, intoID, authorityID string
+		return invoke(args, func() { c.MergeAccounts(fromID, intoID, authorityID) }, &fromID, &intoID, &authorityID)
+	},
+	"MintFromExpertise": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID, domain string
+		var baseScore int
+		return invoke(args, func() { c.MintFromExpertise(agentID, domain, baseScore) }, &agentID, &domain, &baseScore)
+	},
+	"MintTokenWithAttestation": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID, attestation string
+		var virtueScore int
+		return invoke(args, func() { c.MintTokenWithAttestation(agentID, virtueScore, attestation) }, &agentID, &virtueScore, &attestation)
+	},
+	"Quarantine": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID string
+		return invoke(args, func() { c.Quarantine(agentID) }, &agentID)
+	},
+	"RevokeTokenWithReason": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID, revokerID, reason string
+		return invoke(args, func() { c.RevokeTokenWithReason(agentID, revokerID, reason) }, &agentID, &revokerID, &reason)
+	},
+	"Reward": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID string
+		var amount int
+		return invoke(args, func() { c.Reward(agentID, amount) }, &agentID, &amount)
+	},
+	"SetDomainScore": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID, domain string
+		var score int
+		return invoke(args, func() { c.SetDomainScore(agentID, domain, score) }, &agentID, &domain, &score)
+	},
+	"SetDomainWeight": func(c *ReputationContract, args []json.RawMessage) error {
+		var domain string
+		var weight float64
+		return invoke(args, func() { c.SetDomainWeight(domain, weight) }, &domain, &weight)
+	},
+	"SetGenesisKey": func(c *ReputationContract, args []json.RawMessage) error {
+		var key ed25519.PublicKey
+		return invoke(args, func() { c.SetGenesisKey(key) }, &key)
+	},
+	"SetReputationCap": func(c *ReputationContract, args []json.RawMessage) error {
+		var limit int
+		return invoke(args, func() { c.SetReputationCap(limit) }, &limit)
+	},
+	"SetReputationFloor": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID string
+		var floor int
+		return invoke(args, func() { c.SetReputationFloor(agentID, floor) }, &agentID, &floor)
+	},
+	"SetRoleThresholds": func(c *ReputationContract, args []json.RawMessage) error {
+		var t RoleThresholds
+		return invoke(args, func() { c.SetRoleThresholds(t) }, &t)
+	},
+	"SetScoreRules": func(c *ReputationContract, args []json.RawMessage) error {
+		var rules ScoreRules
+		return invoke(args, func() { c.SetScoreRules(rules) }, &rules)
+	},
+	"SetRecoveryAuthority": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID string
+		return invoke(args, func() { c.SetRecoveryAuthority(agentID) }, &agentID)
+	},
+	"SetSoulbound": func(c *ReputationContract, args []json.RawMessage) error {
+		var soulbound bool
+		return invoke(args, func() { c.SetSoulbound(soulbound) }, &soulbound)
+	},
+	"SetVouchRequirement": func(c *ReputationContract, args []json.RawMessage) error {
+		var count, minReputation int
+		return invoke(args, func() { c.SetVouchRequirement(count, minReputation) }, &count, &minReputation)
+	},
+	"SettleStake": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID string
+		var amount int
+		var forfeit bool
+		return invoke(args, func() { c.SettleStake(agentID, amount, forfeit) }, &agentID, &amount, &forfeit)
+	},
+	"Slash": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID, reason string
+		var amount int
+		return invoke(args, func() { c.Slash(agentID, amount, reason) }, &agentID, &amount, &reason)
+	},
+	"TransferReputation": func(c *ReputationContract, args []json.RawMessage) error {
+		var fromID, toID string
+		var amount int
+		return invoke(args, func() { c.TransferReputation(fromID, toID, amount) }, &fromID, &toID, &amount)
+	},
+	"UnmarshalBinary": func(c *ReputationContract, args []json.RawMessage) error {
+		var data []byte
+		return invoke(args, func() { c.UnmarshalBinary(data) }, &data)
+	},
+	"UnmarshalJSON": func(c *ReputationContract, args []json.RawMessage) error {
+		var data []byte
+		return invoke(args, func() { c.UnmarshalJSON(data) }, &data)
+	},
+	"Unquarantine": func(c *ReputationContract, args []json.RawMessage) error {
+		var agentID string
+		return invoke(args, func() { c.Unquarantine(agentID) }, &agentID)
+	},
+	"VouchForMint": func(c *ReputationContract, args []json.RawMessage) error {
+		var candidateID, voucherID string
+		return invoke(args, func() { c.VouchForMint(candidateID, voucherID) }, &candidateID, &voucherID)
+	},
+}
+
+var daoReplayers = map[string]func(*DAOContract, []json.RawMessage) error{
+	"AddAdmin": func(d *DAOContract, args []json.RawMessage) error {
+		var agentID string
+		return invoke(args, func() { d.AddAdmin(agentID) }, &agentID)
+	},
+	"AddTag": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, tag string
+		return invoke(args, func() { d.AddTag(proposalID, tag) }, &proposalID, &tag)
+	},
+	"AmendProposal": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, proposerID, newDescription string
+		return invoke(args, func() { d.AmendProposal(proposalID, proposerID, newDescription) }, &proposalID, &proposerID, &newDescription)
+	},
+	"ChallengeProposal": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, agentID, reason string
+		return invoke(args, func() { d.ChallengeProposal(proposalID, agentID, reason) }, &proposalID, &agentID, &reason)
+	},
+	"CloseVoting": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID string
+		return invoke(args, func() { d.CloseVoting(proposalID) }, &proposalID)
+	},
+	"CoSign": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, agentID string
+		return invoke(args, func() { d.CoSign(proposalID, agentID) }, &proposalID, &agentID)
+	},
+	"CommitVote": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, agentID string
+		var digest []byte
+		return invoke(args, func() { d.CommitVote(proposalID, agentID, digest) }, &proposalID, &agentID, &digest)
+	},
+	"DelegateChecked": func(d *DAOContract, args []json.RawMessage) error {
+		var fromAgentID, toAgentID string
+		return invoke(args, func() { d.DelegateChecked(fromAgentID, toAgentID) }, &fromAgentID, &toAgentID)
+	},
+	"DelegateSplitChecked": func(d *DAOContract, args []json.RawMessage) error {
+		var fromAgentID string
+		var splits map[string]float64
+		return invoke(args, func() { d.DelegateSplitChecked(fromAgentID, splits) }, &fromAgentID, &splits)
+	},
+	"DismissReview": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, adminID string
+		return invoke(args, func() { d.DismissReview(proposalID, adminID) }, &proposalID, &adminID)
+	},
+	"EnactAllReady": func(d *DAOContract, args []json.RawMessage) error {
+		var now int
+		return invoke(args, func() { d.EnactAllReady(now) }, &now)
+	},
+	"EnactBest": func(d *DAOContract, args []json.RawMessage) error {
+		var ids []string
+		return invoke(args, func() { d.EnactBest(ids) }, &ids)
+	},
+	"EnactChecked": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID string
+		return invoke(args, func() { d.EnactChecked(proposalID) }, &proposalID)
+	},
+	"MarkConflicting": func(d *DAOContract, args []json.RawMessage) error {
+		var ids []string
+		return invoke(args, func() { d.MarkConflicting(ids...) }, &ids)
+	},
+	"OpenChallenge": func(d *DAOContract, args []json.RawMessage) error {
+		var targetID, challengerID string
+		var stake int
+		return invoke(args, func() { d.OpenChallenge(targetID, challengerID, stake) }, &targetID, &challengerID, &stake)
+	},
+	"ProposeAndVote": func(d *DAOContract, args []json.RawMessage) error {
+		var id, description, proposerID string
+		var weight int
+		return invoke(args, func() { d.ProposeAndVote(id, description, proposerID, weight) }, &id, &description, &proposerID, &weight)
+	},
+	"ProposeMultisig": func(d *DAOContract, args []json.RawMessage) error {
+		var id, description, proposerID string
+		return invoke(args, func() { d.ProposeMultisig(id, description, proposerID) }, &id, &description, &proposerID)
+	},
+	"ProposeWithEffects": func(d *DAOContract, args []json.RawMessage) error {
+		var id, description, proposerID string
+		var effects []Effect
+		return invoke(args, func() { d.ProposeWithEffects(id, description, proposerID, effects) }, &id, &description, &proposerID, &effects)
+	},
+	"ProposeRuleChecked": func(d *DAOContract, args []json.RawMessage) error {
+		var id, description, proposerID string
+		return invoke(args, func() { d.ProposeRuleChecked(id, description, proposerID) }, &id, &description, &proposerID)
+	},
+	"ProposeRuleFastTrack": func(d *DAOContract, args []json.RawMessage) error {
+		var id, description, adminID string
+		return invoke(args, func() { d.ProposeRuleFastTrack(id, description, adminID) }, &id, &description, &adminID)
+	},
+	"ProposeRuleIdempotent": func(d *DAOContract, args []json.RawMessage) error {
+		var description, proposerID string
+		return invoke(args, func() { d.ProposeRuleIdempotent(description, proposerID) }, &description, &proposerID)
+	},
+	"ProposeRuleInCategory": func(d *DAOContract, args []json.RawMessage) error {
+		var id, category, description, proposerID string
+		return invoke(args, func() { d.ProposeRuleInCategory(id, category, description, proposerID) }, &id, &category, &description, &proposerID)
+	},
+	"RecomputeTally": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID string
+		return invoke(args, func() { d.RecomputeTally(proposalID) }, &proposalID)
+	},
+	"RemoveTag": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, tag string
+		return invoke(args, func() { d.RemoveTag(proposalID, tag) }, &proposalID, &tag)
+	},
+	"ReopenProposal": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, adminID string
+		var newDeadline int
+		return invoke(args, func() { d.ReopenProposal(proposalID, adminID, newDeadline) }, &proposalID, &adminID, &newDeadline)
+	},
+	"ResumeVoting": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, adminID string
+		return invoke(args, func() { d.ResumeVoting(proposalID, adminID) }, &proposalID, &adminID)
+	},
+	"RevealVote": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, agentID string
+		var choice VoteChoice
+		var weight int
+		var salt []byte
+		return invoke(args, func() { d.RevealVote(proposalID, agentID, choice, weight, salt) }, &proposalID, &agentID, &choice, &weight, &salt)
+	},
+	"SetAbstainPolicy": func(d *DAOContract, args []json.RawMessage) error {
+		var countsTowardQuorum, inApprovalDenominator bool
+		return invoke(args, func() { d.SetAbstainPolicy(countsTowardQuorum, inApprovalDenominator) }, &countsTowardQuorum, &inApprovalDenominator)
+	},
+	"SetAgainstMultiplier": func(d *DAOContract, args []json.RawMessage) error {
+		var multiplier float64
+		return invoke(args, func() { d.SetAgainstMultiplier(multiplier) }, &multiplier)
+	},
+	"SetAmendVoteLimit": func(d *DAOContract, args []json.RawMessage) error {
+		var limit int
+		return invoke(args, func() { d.SetAmendVoteLimit(limit) }, &limit)
+	},
+	"SetAuthorReward": func(d *DAOContract, args []json.RawMessage) error {
+		var amount int
+		return invoke(args, func() { d.SetAuthorReward(amount) }, &amount)
+	},
+	"SetBreaker": func(d *DAOContract, args []json.RawMessage) error {
+		var cfg BreakerConfig
+		return invoke(args, func() { d.SetBreaker(cfg) }, &cfg)
+	},
+	"SetChallengeRules": func(d *DAOContract, args []json.RawMessage) error {
+		var rules ChallengeRules
+		return invoke(args, func() { d.SetChallengeRules(rules) }, &rules)
+	},
+	"SetCommitDeposit": func(d *DAOContract, args []json.RawMessage) error {
+		var amount int
+		return invoke(args, func() { d.SetCommitDeposit(amount) }, &amount)
+	},
+	"SetDelegationCap": func(d *DAOContract, args []json.RawMessage) error {
+		var limit DelegationCap
+		return invoke(args, func() { d.SetDelegationCap(limit) }, &limit)
+	},
+	"SetDescriptionRules": func(d *DAOContract, args []json.RawMessage) error {
+		var rules DescriptionRules
+		return invoke(args, func() { d.SetDescriptionRules(rules) }, &rules)
+	},
+	"SetEpochWeightCap": func(d *DAOContract, args []json.RawMessage) error {
+		var maxWeight float64
+		var epochLength int
+		return invoke(args, func() { d.SetEpochWeightCap(maxWeight, epochLength) }, &maxWeight, &epochLength)
+	},
+	"SetFastTrackRules": func(d *DAOContract, args []json.RawMessage) error {
+		var rules FastTrackRules
+		return invoke(args, func() { d.SetFastTrackRules(rules) }, &rules)
+	},
+	"SetIDRules": func(d *DAOContract, args []json.RawMessage) error {
+		var rules IDRules
+		return invoke(args, func() { d.SetIDRules(rules) }, &rules)
+	},
+	"SetMinDelegationReputation": func(d *DAOContract, args []json.RawMessage) error {
+		var minimum int
+		return invoke(args, func() { d.SetMinDelegationReputation(minimum) }, &minimum)
+	},
+	"SetMinReputationShare": func(d *DAOContract, args []json.RawMessage) error {
+		var share float64
+		return invoke(args, func() { d.SetMinReputationShare(share) }, &share)
+	},
+	"SetMinTurnout": func(d *DAOContract, args []json.RawMessage) error {
+		var weight float64
+		return invoke(args, func() { d.SetMinTurnout(weight) }, &weight)
+	},
+	"SetMinVoters": func(d *DAOContract, args []json.RawMessage) error {
+		var count int
+		return invoke(args, func() { d.SetMinVoters(count) }, &count)
+	},
+	"SetProposalLimits": func(d *DAOContract, args []json.RawMessage) error {
+		var limits ProposalLimits
+		return invoke(args, func() { d.SetProposalLimits(limits) }, &limits)
+	},
+	"SetProposalPolicy": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, name string
+		return invoke(args, func() { d.SetProposalPolicy(proposalID, name) }, &proposalID, &name)
+	},
+	"SetElectorateFrozen": func(d *DAOContract, args []json.RawMessage) error {
+		var frozen bool
+		return invoke(args, func() { d.SetElectorateFrozen(frozen) }, &frozen)
+	},
+	"SetQuorumElectorate": func(d *DAOContract, args []json.RawMessage) error {
+		var electorate QuorumElectorate
+		return invoke(args, func() { d.SetQuorumElectorate(electorate) }, &electorate)
+	},
+	"SetQuorum": func(d *DAOContract, args []json.RawMessage) error {
+		var quorum float64
+		return invoke(args, func() { d.SetQuorum(quorum) }, &quorum)
+	},
+	"SetRequiredRole": func(d *DAOContract, args []json.RawMessage) error {
+		var action string
+		var role Role
+		return invoke(args, func() { d.SetRequiredRole(action, role) }, &action, &role)
+	},
+	"SetRevealWindow": func(d *DAOContract, args []json.RawMessage) error {
+		var seconds int
+		return invoke(args, func() { d.SetRevealWindow(seconds) }, &seconds)
+	},
+	"SetSelfVoting": func(d *DAOContract, args []json.RawMessage) error {
+		var allowed bool
+		return invoke(args, func() { d.SetSelfVoting(allowed) }, &allowed)
+	},
+	"SetSponsorRules": func(d *DAOContract, args []json.RawMessage) error {
+		var rules SponsorRules
+		return invoke(args, func() { d.SetSponsorRules(rules) }, &rules)
+	},
+	"SetSpamRules": func(d *DAOContract, args []json.RawMessage) error {
+		var rules SpamRules
+		return invoke(args, func() { d.SetSpamRules(rules) }, &rules)
+	},
+	"SetTiePolicy": func(d *DAOContract, args []json.RawMessage) error {
+		var policy TiePolicy
+		var extension int
+		return invoke(args, func() { d.SetTiePolicy(policy, extension) }, &policy, &extension)
+	},
+	"SetVotingPeriod": func(d *DAOContract, args []json.RawMessage) error {
+		var seconds int
+		return invoke(args, func() { d.SetVotingPeriod(seconds) }, &seconds)
+	},
+	"SetWinningSideReward": func(d *DAOContract, args []json.RawMessage) error {
+		var amount int
+		return invoke(args, func() { d.SetWinningSideReward(amount) }, &amount)
+	},
+	"UnmarshalBinary": func(d *DAOContract, args []json.RawMessage) error {
+		var data []byte
+		return invoke(args, func() { d.UnmarshalBinary(data) }, &data)
+	},
+	"UnmarshalJSON": func(d *DAOContract, args []json.RawMessage) error {
+		var data []byte
+		return invoke(args, func() { d.UnmarshalJSON(data) }, &data)
+	},
+	"TransferSponsorship": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, fromAgentID, toAgentID string
+		return invoke(args, func() { d.TransferSponsorship(proposalID, fromAgentID, toAgentID) }, &proposalID, &fromAgentID, &toAgentID)
+	},
+	"VoteWithReason": func(d *DAOContract, args []json.RawMessage) error {
+		var proposalID, agentID, reason string
+		var choice VoteChoice
+		var weight int
+		return invoke(args, func() { d.VoteWithReason(proposalID, agentID, choice, weight, reason) }, &proposalID, &agentID, &choice, &weight, &reason)
+	},
+}
@@ -1,46 +1,365 @@
 package reputation
 
-import "math"
+import (
+	"crypto/ed25519"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+)
 
 type ReputationContract struct {
+	eventLog
+	mu          sync.RWMutex
+	ops         *opLog
 	reputations map[string]int  // agentID -> reputation score
+	index       reputationIndex // reputations in score order
+	total       int             // sum of reputations, kept with the index
 	tokens      map[string]bool // agentID -> hasToken
+
+	roleThresholds RoleThresholds
+	scoreRules     ScoreRules
+	reputationCap  int // rewards never lift a score above this; zero means uncapped
+	soulbound      bool
+	// recoveryAuthority may merge duplicate accounts; empty means no one
+	recoveryAuthority string
+
+	// Web-of-trust onboarding: when vouchesRequired is set, minting also
+	// needs that many distinct token holders with at least vouchMinReputation.
+	vouchesRequired    int
+	vouchMinReputation int
+	vouches            map[string]map[string]bool // candidateID -> voucherIDs
+
+	floors      map[string]int  // protected agents -> minimum reputation
+	quarantined map[string]bool // agents frozen out of governance pending review
+	stakes      map[string]int  // reputation escrowed against open challenges
+
+	attestations map[string]string // agentID -> evidence backing their minted score
+
+	genesisKey     ed25519.PublicKey
+	genesisApplied bool
+
+	logger        Logger
+	oracle        ExpertiseOracle // nil keeps the mock that verifies everyone
+	domainWeights map[string]float64
+	domainScores  map[string]map[string]int // domain -> agentID -> score
+
+	// Oracle answers are cached in memory only; they are not contract state.
+	expertiseTTL   int
+	expertiseCache map[string]map[string]expertiseResult // agentID -> domain -> result
+	clock          Clock
+	oracleFailure  OracleFailurePolicy
+}
+
+// AgentSummary is a read-only view of one agent's standing.
+type AgentSummary struct {
+	AgentID     string
+	Reputation  int
+	HasToken    bool
+	Role        Role
+	Floor       int
+	Quarantined bool
 }
 
 func NewReputationContract() *ReputationContract {
 	return &ReputationContract{
-		reputations: make(map[string]int),
-		tokens:      make(map[string]bool),
+		ops:            &opLog{},
+		reputations:    make(map[string]int),
+		tokens:         make(map[string]bool),
+		roleThresholds: DefaultRoleThresholds,
+		scoreRules:     DefaultScoreRules,
+		reputationCap:  100,
+		soulbound:      true,
+		vouches:        make(map[string]map[string]bool),
+		floors:         make(map[string]int),
+		quarantined:    make(map[string]bool),
+		stakes:         make(map[string]int),
+		domainWeights:  make(map[string]float64),
+		domainScores:   make(map[string]map[string]int),
+		expertiseCache: make(map[string]map[string]expertiseResult),
+		clock:          systemClock{},
+		attestations:   make(map[string]string),
+		logger:         nopLogger{},
+	}
+}
+
+func (c *ReputationContract) AgentSummary(agentID string) AgentSummary {
+	role := c.RoleFor(agentID)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return AgentSummary{
+		AgentID:     agentID,
+		Reputation:  c.reputations[agentID],
+		HasToken:    c.tokens[agentID],
+		Role:        role,
+		Floor:       c.floors[agentID],
+		Quarantined: c.quarantined[agentID],
 	}
 }
 
 func (c *ReputationContract) MintToken(agentID string, virtueScore int) bool {
-	if virtueScore > 80 && !c.tokens[agentID] {
+	return c.MintTokenWithAttestation(agentID, virtueScore, "")
+}
+
+// MintTokenWithAttestation mints like MintToken and records attestation, an
+// external reference (hash, URL or evaluator ID) justifying the score.
+func (c *ReputationContract) MintTokenWithAttestation(agentID string, virtueScore int, attestation string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("MintTokenWithAttestation", agentID, virtueScore, attestation)
+	virtueScore, valid := c.scoreRules.normalizeScore(virtueScore)
+	if valid && virtueScore > 80 && !c.tokens[agentID] && len(c.vouches[agentID]) >= c.vouchesRequired {
 		c.tokens[agentID] = true
-		c.reputations[agentID] = virtueScore
+		c.setReputation(agentID, virtueScore)
+		delete(c.vouches, agentID)
+		if attestation != "" {
+			c.attestations[agentID] = attestation
+		}
+		c.emit(Event{
+			Type:    EventTokenMinted,
+			Subject: agentID,
+			Details: map[string]string{
+				"virtue_score": strconv.Itoa(virtueScore),
+				"attestation":  attestation,
+			},
+		})
+		c.logger.Info("token_minted", "agent", agentID, "virtue_score", virtueScore)
 		return true
 	}
 	return false
 }
 
+// GetAttestation returns the evidence recorded when agentID was minted, if any.
+func (c *ReputationContract) GetAttestation(agentID string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.attestations[agentID]
+}
+
+// SetVouchRequirement makes MintToken require count vouches from token
+// holders with at least minReputation. A count of zero disables vouching.
+func (c *ReputationContract) SetVouchRequirement(count int, minReputation int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SetVouchRequirement", count, minReputation)
+	c.vouchesRequired = count
+	c.vouchMinReputation = minReputation
+}
+
+// VouchForMint records voucherID's endorsement of candidateID. Repeat
+// vouches from the same voucher are rejected rather than double-counted.
+func (c *ReputationContract) VouchForMint(candidateID string, voucherID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("VouchForMint", candidateID, voucherID)
+	if candidateID == voucherID || c.tokens[candidateID] {
+		return false
+	}
+	if !c.tokens[voucherID] || c.reputations[voucherID] < c.vouchMinReputation {
+		return false
+	}
+	if c.vouches[candidateID] == nil {
+		c.vouches[candidateID] = make(map[string]bool)
+	}
+	if c.vouches[candidateID][voucherID] {
+		return false
+	}
+	c.vouches[candidateID][voucherID] = true
+	return true
+}
+
 func (c *ReputationContract) RevokeToken(agentID string) {
-	if c.tokens[agentID] {
-		delete(c.tokens, agentID)
-		c.reputations[agentID] = 0
+	c.RevokeTokenWithReason(agentID, "", "")
+}
+
+// RevokeTokenWithReason revokes agentID's token and records who revoked it
+// and why. Revoking an agent without a token is a no-op.
+func (c *ReputationContract) RevokeTokenWithReason(agentID string, revokerID string, reason string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("RevokeTokenWithReason", agentID, revokerID, reason)
+	if !c.tokens[agentID] {
+		return false
+	}
+	prior := c.reputations[agentID]
+	delete(c.tokens, agentID)
+	c.setReputation(agentID, 0)
+	c.emit(Event{
+		Type:    EventTokenRevoked,
+		Subject: agentID,
+		Actor:   revokerID,
+		Details: map[string]string{
+			"reason":           reason,
+			"prior_reputation": strconv.Itoa(prior),
+		},
+	})
+	c.logger.Info("token_revoked", "agent", agentID, "revoker", revokerID, "reason", reason)
+	return true
+}
+
+func (c *ReputationContract) SetReputationCap(limit int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SetReputationCap", limit)
+	c.reputationCap = limit
+}
+
+// Reward raises agentID's reputation by amount, up to the reputation cap,
+// and returns the new score.
+func (c *ReputationContract) Reward(agentID string, amount int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("Reward", agentID, amount)
+	return c.reward(agentID, amount)
+}
+
+func (c *ReputationContract) reward(agentID string, amount int) int {
+	rep := c.reputations[agentID] + amount
+	if c.reputationCap > 0 && rep > c.reputationCap {
+		rep = max(c.reputationCap, c.reputations[agentID])
+	}
+	c.setReputation(agentID, rep)
+	return rep
+}
+
+// SetReputationFloor protects agentID from being slashed or decayed below
+// floor. A floor of zero removes the protection.
+func (c *ReputationContract) SetReputationFloor(agentID string, floor int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SetReputationFloor", agentID, floor)
+	if floor <= 0 {
+		delete(c.floors, agentID)
+		return
+	}
+	c.floors[agentID] = floor
+}
+
+// Slash lowers agentID's reputation by amount, never below zero or the
+// agent's protected floor, and returns the new score.
+func (c *ReputationContract) Slash(agentID string, amount int, reason string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("Slash", agentID, amount, reason)
+	return c.slash(agentID, amount, reason)
+}
+
+func (c *ReputationContract) slash(agentID string, amount int, reason string) int {
+	prior := c.reputations[agentID]
+	rep := c.lowerTo(agentID, prior-amount)
+	c.emit(Event{
+		Type:    EventReputationSlashed,
+		Subject: agentID,
+		Details: map[string]string{
+			"reason":           reason,
+			"prior_reputation": strconv.Itoa(prior),
+			"reputation":       strconv.Itoa(rep),
+		},
+	})
+	c.logger.Info("reputation_slashed", "agent", agentID, "prior", prior, "reputation", rep, "reason", reason)
+	return rep
+}
+
+// Decay reduces every agent's reputation by fraction (0-1), truncating, while
+// respecting protected floors.
+func (c *ReputationContract) Decay(fraction float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("Decay", fraction)
+	for agentID, rep := range c.reputations {
+		c.lowerTo(agentID, rep-int(float64(rep)*fraction))
+	}
+}
+
+// lowerTo sets a reduced score, clamped to zero and the agent's floor. It
+// never raises a score that already sits below the floor.
+func (c *ReputationContract) lowerTo(agentID string, rep int) int {
+	rep = max(rep, 0)
+	if floor, protected := c.floors[agentID]; protected && rep < floor {
+		rep = min(floor, c.reputations[agentID])
 	}
+	c.setReputation(agentID, rep)
+	return rep
 }
 
 func (c *ReputationContract) GetReputation(agentID string) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.reputations[agentID]
 }
 
+// GetReputations reads several scores under one lock. Every requested agent
+// appears in the result; unknown agents map to zero, as with GetReputation.
+func (c *ReputationContract) GetReputations(agentIDs []string) map[string]int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	reps := make(map[string]int, len(agentIDs))
+	for _, agentID := range agentIDs {
+		reps[agentID] = c.reputations[agentID]
+	}
+	return reps
+}
+
+// Agents lists every agent with a reputation record, sorted by ID.
+func (c *ReputationContract) Agents() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	agents := make([]string, 0, len(c.reputations))
+	for agentID := range c.reputations {
+		agents = append(agents, agentID)
+	}
+	sort.Strings(agents)
+	return agents
+}
+
+func (c *ReputationContract) HasToken(agentID string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.tokens[agentID]
+}
+
+// MemberCount is the number of token holders.
+func (c *ReputationContract) MemberCount() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.tokens)
+}
+
+// EligibleElectorate counts the token holders and sums the reputation of
+// agents who can currently take part in governance: those not quarantined
+// and with reputation above zero. Revoked and fully slashed agents drop out.
+// tokenHoldersOnly leaves out the reputation of agents without a token.
+func (c *ReputationContract) EligibleElectorate(tokenHoldersOnly bool) (members int, reputation int) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	// Scanned from the top so the zero and negative tail is never visited
+	for i := len(c.index) - 1; i >= 0 && c.index[i].Score > 0; i-- {
+		agentID, rep := c.index[i].AgentID, c.index[i].Score
+		if c.quarantined[agentID] || (tokenHoldersOnly && !c.tokens[agentID]) {
+			continue
+		}
+		if c.tokens[agentID] {
+			members++
+		}
+		reputation += rep
+	}
+	return members, reputation
+}
+
+func (c *ReputationContract) TotalReputation() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.total
+}
+
 func (c *ReputationContract) QuadraticVote(agentID string, voteWeight int) float64 {
-	rep := c.GetReputation(agentID)
-	return float64(voteWeight) * math.Sqrt(float64(rep))
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return quadraticWeight(voteWeight, c.reputations[agentID])
 }
 
-// Stub for proof-of-expertise integration
-func (c *ReputationContract) VerifyExpertise(agentID string, domain string) bool {
-	// Mock external query
-	return true
+// quadraticWeight treats negative reputation as zero so the result is
+// always finite.
+func quadraticWeight(voteWeight int, rep int) float64 {
+	return float64(voteWeight) * math.Sqrt(float64(max(rep, 0)))
 }
@@ -0,0 +1,91 @@
+package reputation
+
+import "sort"
+
+// reputationIndex mirrors the reputations map sorted by score, then agent
+// ID, so ordered scans need not sort the whole membership. It is updated on
+// every write and rebuilt when state is restored.
+type reputationIndex []AgentScore
+
+func (ix reputationIndex) position(agentID string, rep int) int {
+	return sort.Search(len(ix), func(i int) bool {
+		return ix[i].Score > rep || (ix[i].Score == rep && ix[i].AgentID >= agentID)
+	})
+}
+
+func (ix *reputationIndex) insert(agentID string, rep int) {
+	i := ix.position(agentID, rep)
+	*ix = append(*ix, AgentScore{})
+	copy((*ix)[i+1:], (*ix)[i:])
+	(*ix)[i] = AgentScore{AgentID: agentID, Score: rep}
+}
+
+func (ix *reputationIndex) remove(agentID string, rep int) {
+	if i := ix.position(agentID, rep); i < len(*ix) && (*ix)[i].AgentID == agentID {
+		*ix = append((*ix)[:i], (*ix)[i+1:]...)
+	}
+}
+
+func buildReputationIndex(reputations map[string]int) reputationIndex {
+	ix := make(reputationIndex, 0, len(reputations))
+	for agentID, rep := range reputations {
+		ix = append(ix, AgentScore{AgentID: agentID, Score: rep})
+	}
+	sort.Slice(ix, func(i, j int) bool {
+		if ix[i].Score != ix[j].Score {
+			return ix[i].Score < ix[j].Score
+		}
+		return ix[i].AgentID < ix[j].AgentID
+	})
+	return ix
+}
+
+func sumReputations(reputations map[string]int) int {
+	total := 0
+	for _, rep := range reputations {
+		total += rep
+	}
+	return total
+}
+
+// setReputation is the only way scores change, keeping the index and total
+// in step; the caller holds c.mu.
+func (c *ReputationContract) setReputation(agentID string, rep int) {
+	if old, exists := c.reputations[agentID]; exists {
+		if old == rep {
+			return
+		}
+		c.index.remove(agentID, old)
+		c.total -= old
+	}
+	c.reputations[agentID] = rep
+	c.index.insert(agentID, rep)
+	c.total += rep
+}
+
+func (c *ReputationContract) deleteReputation(agentID string) {
+	if old, exists := c.reputations[agentID]; exists {
+		c.index.remove(agentID, old)
+		c.total -= old
+		delete(c.reputations, agentID)
+	}
+}
+
+// IterateByReputation calls fn for each agent with a reputation record in
+// score order, highest first when desc is set, until fn returns false.
+// Agents with equal scores are visited in agent ID order, reversed when
+// descending. The contract is read-locked throughout, so fn must not call
+// back into it.
+func (c *ReputationContract) IterateByReputation(desc bool, fn func(agentID string, rep int) bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for n := range c.index {
+		i := n
+		if desc {
+			i = len(c.index) - 1 - n
+		}
+		if !fn(c.index[i].AgentID, c.index[i].Score) {
+			return
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package reputation
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+// churn applies a seeded random mix of every reputation-changing call.
+func churn(rep *ReputationContract, seed int64, steps int) {
+	rng := rand.New(rand.NewSource(seed))
+	agent := func() string { return "a" + strconv.Itoa(rng.Intn(40)) }
+	for i := 0; i < steps; i++ {
+		switch rng.Intn(8) {
+		case 0:
+			rep.MintToken(agent(), 81+rng.Intn(19))
+		case 1:
+			rep.RevokeToken(agent())
+		case 2:
+			rep.Slash(agent(), rng.Intn(30), "")
+		case 3:
+			rep.Decay(0.05)
+		case 4:
+			rep.Reward(agent(), rng.Intn(20))
+		case 5:
+			rep.TransferReputation(agent(), agent(), rng.Intn(10))
+		case 6:
+			rep.MergeAccounts(agent(), agent(), "root")
+		case 7:
+			a := agent()
+			if rep.LockStake(a, 3) && rng.Intn(2) == 0 {
+				rep.SettleStake(a, 3, rng.Intn(2) == 0)
+			}
+		}
+	}
+}
+
+func iterated(rep *ReputationContract, desc bool) []AgentScore {
+	var scores []AgentScore
+	rep.IterateByReputation(desc, func(agentID string, score int) bool {
+		scores = append(scores, AgentScore{AgentID: agentID, Score: score})
+		return true
+	})
+	return scores
+}
+
+func TestReputationIndexStaysConsistent(t *testing.T) {
+	rep := NewReputationContract()
+	rep.SetSoulbound(false)
+	rep.SetReputationCap(0)
+	rep.SetRecoveryAuthority("root")
+	churn(rep, 1, 3000)
+	if want := buildReputationIndex(rep.reputations); !reflect.DeepEqual(rep.index, want) {
+		t.Fatalf("index drifted from the reputations map:\n got %v\nwant %v", rep.index, want)
+	}
+	if want := sumReputations(rep.reputations); rep.TotalReputation() != want {
+		t.Fatalf("TotalReputation = %d, want %d", rep.TotalReputation(), want)
+	}
+	var want []AgentScore
+	for _, agentID := range rep.Agents() {
+		want = append(want, AgentScore{AgentID: agentID, Score: rep.GetReputation(agentID)})
+	}
+	sort.Slice(want, func(i, j int) bool {
+		if want[i].Score != want[j].Score {
+			return want[i].Score > want[j].Score
+		}
+		return want[i].AgentID > want[j].AgentID
+	})
+	if got := iterated(rep, true); !reflect.DeepEqual(got, want) {
+		t.Fatalf("descending scan:\n got %v\nwant %v", got, want)
+	}
+
+	blob, _ := rep.MarshalJSON()
+	loaded := NewReputationContract()
+	if err := loaded.UnmarshalJSON(blob); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(iterated(loaded, true), want) {
+		t.Fatal("restored contract iterates in a different order")
+	}
+}
+
+func TestIterateByReputationStops(t *testing.T) {
+	rep := NewReputationContract()
+	for i := 0; i < 5; i++ {
+		rep.MintToken(fmt.Sprint("m", i), 81+i)
+	}
+	visited := 0
+	rep.IterateByReputation(false, func(string, int) bool {
+		visited++
+		return visited < 3
+	})
+	if visited != 3 {
+		t.Fatalf("visited %d agents, want 3", visited)
+	}
+}
+
+func benchmarkMembership(b *testing.B, members int) *ReputationContract {
+	b.Helper()
+	rep := NewReputationContract()
+	rep.SetReputationCap(0)
+	for i := 0; i < members; i++ {
+		rep.Reward(fmt.Sprint("m", i), i%1000)
+	}
+	return rep
+}
+
+func BenchmarkIterateByReputationTop10(b *testing.B) {
+	rep := benchmarkMembership(b, 20_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n := 0
+		rep.IterateByReputation(true, func(string, int) bool {
+			n++
+			return n < 10
+		})
+	}
+}
+
+func BenchmarkRewardIndexed(b *testing.B) {
+	rep := benchmarkMembership(b, 20_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rep.Reward(fmt.Sprint("m", i%20_000), 1)
+	}
+}
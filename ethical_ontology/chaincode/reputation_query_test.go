@@ -0,0 +1,20 @@
+package reputation
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestGetReputations(t *testing.T) {
+	rep := NewReputationContract()
+	rep.MintToken("a", 81)
+	rep.MintToken("b", 90)
+	got := rep.GetReputations([]string{"a", "b", "unknown", "a"})
+	want := map[string]int{"a": 81, "b": 90, "unknown": 0}
+	if !maps.Equal(got, want) {
+		t.Fatalf("GetReputations = %v, want %v", got, want)
+	}
+	if got := rep.GetReputations(nil); len(got) != 0 {
+		t.Fatalf("empty query returned %v", got)
+	}
+}
@@ -0,0 +1,84 @@
+package reputation
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func shareDAO(t *testing.T) (*DAOContract, *ReputationContract) {
+	t.Helper()
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90, "h": 90})
+	dao.SetMinReputationShare(0.5)
+	dao.ProposeRule("p", "a valid description", "f")
+	return dao, rep
+}
+
+func TestReputationShareUsesCreationSnapshot(t *testing.T) {
+	dao, rep := shareDAO(t)
+	if got := dao.GetProposal("p").EligibleReputation; got != 261 {
+		t.Fatalf("EligibleReputation = %d, want 261", got)
+	}
+	dao.Vote("p", "f", true, 5)
+	if pass, reason := dao.WouldPassNow("p"); pass {
+		t.Fatalf("passed with under half the reputation: %s", reason)
+	}
+	// A shrinking electorate doesn't lower the bar
+	rep.RevokeToken("h")
+	if pass, _ := dao.WouldPassNow("p"); pass {
+		t.Fatal("revoking tokens after creation let the proposal pass")
+	}
+	dao.Vote("p", "g", true, 1)
+	if pass, reason := dao.WouldPassNow("p"); !pass {
+		t.Fatalf("over half the reputation voted: %s", reason)
+	}
+}
+
+func TestReputationShareSnapshotSurvivesSerialization(t *testing.T) {
+	dao, rep := shareDAO(t)
+	dao.Vote("p", "f", true, 5)
+	jsonBlob, err := dao.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	binaryBlob, err := dao.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rep.Reward("h", 50) // growth after creation must not move the snapshot
+	fromJSON, fromBinary := NewDAOContract(rep, 0), NewDAOContract(rep, 0)
+	if err := fromJSON.UnmarshalJSON(jsonBlob); err != nil {
+		t.Fatal(err)
+	}
+	if err := fromBinary.UnmarshalBinary(binaryBlob); err != nil {
+		t.Fatal(err)
+	}
+	for name, loaded := range map[string]*DAOContract{"JSON": fromJSON, "binary": fromBinary} {
+		if got := loaded.GetProposal("p").EligibleReputation; got != 261 {
+			t.Fatalf("%s: EligibleReputation = %d, want 261", name, got)
+		}
+		if got := loaded.Config().MinReputationShare; got != 0.5 {
+			t.Fatalf("%s: MinReputationShare = %v, want 0.5", name, got)
+		}
+		if pass, _ := loaded.WouldPassNow("p"); pass {
+			t.Fatalf("%s: loaded proposal passed below the snapshot share", name)
+		}
+	}
+}
+
+func TestOldStateKeepsRuleDefaults(t *testing.T) {
+	blob, _ := hex.DecodeString(v1DAOBlob)
+	fromBinary := NewDAOContract(NewReputationContract(), 0)
+	if err := fromBinary.UnmarshalBinary(blob); err != nil {
+		t.Fatal(err)
+	}
+	fromJSON := NewDAOContract(NewReputationContract(), 0)
+	if err := fromJSON.UnmarshalJSON([]byte(`{"quorum":0.5,"against_multiplier":1}`)); err != nil {
+		t.Fatal(err)
+	}
+	for name, loaded := range map[string]*DAOContract{"binary": fromBinary, "JSON": fromJSON} {
+		cfg := loaded.Config()
+		if cfg.IDRules != DefaultIDRules || cfg.SpamRules != DefaultSpamRules {
+			t.Fatalf("%s: rules absent from old state lost their defaults: %+v %+v", name, cfg.IDRules, cfg.SpamRules)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+var _ ReputationSource = (*ReputationContract)(nil)
+
+func TestDAOWithStubReputationSource(t *testing.T) {
+	source := &stubSource{reputations: map[string]int{"a": 100, "b": 4}}
+	dao := NewDAOContract(source, 0.6)
+	dao.SetAuthorReward(5)
+	if err := dao.ProposeRuleChecked("q", "a valid description", "b"); !errors.Is(err, ErrInsufficientRole) {
+		t.Fatalf("low-reputation proposer: got %v, want ErrInsufficientRole", err)
+	}
+	if err := dao.ProposeRuleChecked("p", "a valid description", "a"); err != nil {
+		t.Fatal(err)
+	}
+	dao.Vote("p", "a", true, 1)
+	dao.Vote("p", "b", false, 1)
+	results, _ := dao.GetProposalResults("p")
+	if results.VotesFor != 10 || results.VotesAgainst != 2 {
+		t.Fatalf("votes weighted from the stub: %+v", results)
+	}
+	if !dao.Enact("p") {
+		t.Fatal("Enact refused")
+	}
+	if got := source.GetReputation("a"); got != 105 {
+		t.Fatalf("author reward did not reach the stub: reputation %d", got)
+	}
+}
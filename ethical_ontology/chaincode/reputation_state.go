@@ -0,0 +1,120 @@
+package reputation
+
+import "encoding/json"
+
+// reputationState is the serialized form of a ReputationContract.
+type reputationState struct {
+	Reputations        map[string]int             `json:"reputations"`
+	Tokens             map[string]bool            `json:"tokens"`
+	RoleThresholds     RoleThresholds             `json:"role_thresholds"`
+	ScoreRules         *ScoreRules                `json:"score_rules,omitempty"`
+	ReputationCap      int                        `json:"reputation_cap"`
+	Soulbound          *bool                      `json:"soulbound,omitempty"`
+	RecoveryAuthority  string                     `json:"recovery_authority"`
+	VouchesRequired    int                        `json:"vouches_required"`
+	VouchMinReputation int                        `json:"vouch_min_reputation"`
+	Vouches            map[string]map[string]bool `json:"vouches"`
+	Floors             map[string]int             `json:"floors"`
+	Quarantined        map[string]bool            `json:"quarantined"`
+	Stakes             map[string]int             `json:"stakes"`
+	DomainWeights      map[string]float64         `json:"domain_weights"`
+	DomainScores       map[string]map[string]int  `json:"domain_scores"`
+	Attestations       map[string]string          `json:"attestations"`
+	GenesisApplied     bool                       `json:"genesis_applied"`
+	Events             []Event                    `json:"events"`
+}
+
+func (c *ReputationContract) MarshalJSON() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return json.Marshal(c.state())
+}
+
+func (c *ReputationContract) UnmarshalJSON(data []byte) error {
+	var state reputationState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.restore(state)
+	// Recorded after restoring, which equips a zero-value contract with a log
+	c.record("UnmarshalJSON", data)
+	return nil
+}
+
+// state captures the contract for serialization; the caller holds c.mu.
+func (c *ReputationContract) state() reputationState {
+	return reputationState{
+		Reputations:        c.reputations,
+		Tokens:             c.tokens,
+		RoleThresholds:     c.roleThresholds,
+		ScoreRules:         &c.scoreRules,
+		ReputationCap:      c.reputationCap,
+		Soulbound:          &c.soulbound,
+		RecoveryAuthority:  c.recoveryAuthority,
+		VouchesRequired:    c.vouchesRequired,
+		VouchMinReputation: c.vouchMinReputation,
+		Vouches:            c.vouches,
+		Floors:             c.floors,
+		Quarantined:        c.quarantined,
+		Stakes:             c.stakes,
+		DomainWeights:      c.domainWeights,
+		DomainScores:       c.domainScores,
+		Attestations:       c.attestations,
+		GenesisApplied:     c.genesisApplied,
+		Events:             c.Events(),
+	}
+}
+
+// restore replaces the contract's state with a decoded one; the caller holds
+// c.mu for writing.
+func (c *ReputationContract) restore(state reputationState) {
+	fresh := NewReputationContract()
+	c.reputations = orEmpty(state.Reputations, fresh.reputations)
+	c.index = buildReputationIndex(c.reputations)
+	c.total = sumReputations(c.reputations)
+	c.tokens = orEmpty(state.Tokens, fresh.tokens)
+	c.roleThresholds = state.RoleThresholds
+	// Blobs written before score rules existed keep the defaults
+	c.scoreRules = fresh.scoreRules
+	if state.ScoreRules != nil {
+		c.scoreRules = *state.ScoreRules
+	}
+	c.reputationCap = state.ReputationCap
+	c.soulbound = fresh.soulbound
+	if state.Soulbound != nil {
+		c.soulbound = *state.Soulbound
+	}
+	c.recoveryAuthority = state.RecoveryAuthority
+	c.vouchesRequired = state.VouchesRequired
+	c.vouchMinReputation = state.VouchMinReputation
+	c.vouches = orEmpty(state.Vouches, fresh.vouches)
+	c.floors = orEmpty(state.Floors, fresh.floors)
+	c.quarantined = orEmpty(state.Quarantined, fresh.quarantined)
+	c.stakes = orEmpty(state.Stakes, fresh.stakes)
+	c.domainWeights = orEmpty(state.DomainWeights, fresh.domainWeights)
+	c.domainScores = orEmpty(state.DomainScores, fresh.domainScores)
+	c.attestations = orEmpty(state.Attestations, fresh.attestations)
+	c.genesisApplied = state.GenesisApplied
+	c.logger = orNop(c.logger)
+	if c.clock == nil {
+		c.clock = fresh.clock
+	}
+	if c.ops == nil {
+		c.ops = fresh.ops
+	}
+	c.expertiseCache = fresh.expertiseCache
+	c.eventsMu.Lock()
+	c.events = state.Events
+	c.eventsMu.Unlock()
+}
+
+// orEmpty substitutes an initialised map for one that was absent in the
+// serialized state, so later writes never hit a nil map.
+func orEmpty[K comparable, V any](m map[K]V, empty map[K]V) map[K]V {
+	if m == nil {
+		return empty
+	}
+	return m
+}
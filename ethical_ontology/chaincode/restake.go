@@ -0,0 +1,104 @@
+package reputation
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// LockStake moves amount of agentID's reputation into escrow, where it no
+// longer counts toward votes or roles, pending a challenge's outcome.
+func (c *ReputationContract) LockStake(agentID string, amount int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("LockStake", agentID, amount)
+	return c.lockStake(agentID, amount)
+}
+
+func (c *ReputationContract) lockStake(agentID string, amount int) bool {
+	if amount <= 0 || c.reputations[agentID] < amount {
+		return false
+	}
+	c.setReputation(agentID, c.reputations[agentID]-amount)
+	c.stakes[agentID] += amount
+	return true
+}
+
+// SettleStake releases amount from agentID's escrow, burning it when forfeit
+// is set and returning it to their reputation otherwise.
+func (c *ReputationContract) SettleStake(agentID string, amount int, forfeit bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SettleStake", agentID, amount, forfeit)
+	c.settleStake(agentID, amount, forfeit)
+}
+
+func (c *ReputationContract) settleStake(agentID string, amount int, forfeit bool) {
+	amount = min(amount, c.stakes[agentID])
+	if c.stakes[agentID] -= amount; c.stakes[agentID] == 0 {
+		delete(c.stakes, agentID)
+	}
+	eventType := EventStakeForfeited
+	if !forfeit {
+		c.setReputation(agentID, c.reputations[agentID]+amount)
+		eventType = EventStakeReturned
+	}
+	c.emit(Event{
+		Type:    eventType,
+		Subject: agentID,
+		Details: map[string]string{"amount": strconv.Itoa(amount)},
+	})
+}
+
+// LockedStake is the reputation agentID currently has in escrow.
+func (c *ReputationContract) LockedStake(agentID string) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.stakes[agentID]
+}
+
+// OpenChallenge disputes targetID's reputation by opening an ordinary
+// proposal, proposed by challengerID, and locking stake of the target's
+// reputation. The stake is forfeited if the proposal is enacted and returned
+// if it is rejected, expires or is superseded. It returns the proposal ID.
+func (d *DAOContract) OpenChallenge(targetID string, challengerID string, stake int) (string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("OpenChallenge", targetID, challengerID, stake)
+	for _, prop := range d.proposals {
+		if prop.Active && prop.ChallengeTarget == targetID {
+			return "", fmt.Errorf("%w: %q", ErrChallengePending, prop.ID)
+		}
+	}
+	if stake <= 0 || d.reputation.GetReputation(targetID) < stake {
+		return "", ErrInsufficientStake
+	}
+	id := fmt.Sprintf("challenge-%s-%d", targetID, d.proposalCount+1)
+	description := fmt.Sprintf("Challenge to the reputation of %s, staking %d", targetID, stake)
+	undo := d.proposeUndo(challengerID)
+	prop, err := d.propose(id, "reputation-challenge", description, challengerID)
+	if err != nil {
+		return "", err
+	}
+	if !d.reputation.LockStake(targetID, stake) {
+		d.withdraw(prop, undo)
+		return "", ErrInsufficientStake
+	}
+	prop.ChallengeTarget = targetID
+	prop.Stake = stake
+	d.emit(Event{
+		Type:    EventReputationChallenged,
+		Subject: targetID,
+		Actor:   challengerID,
+		Details: map[string]string{"proposal": prop.ID, "stake": strconv.Itoa(stake)},
+	})
+	return prop.ID, nil
+}
+
+// settleChallenge resolves a reputation challenge once its proposal is decided.
+func (d *DAOContract) settleChallenge(prop *Proposal, upheld bool) {
+	if prop.ChallengeTarget == "" || prop.Stake == 0 {
+		return
+	}
+	d.reputation.SettleStake(prop.ChallengeTarget, prop.Stake, upheld)
+	prop.Stake = 0
+}
@@ -0,0 +1,65 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestChallengeStakeSettles(t *testing.T) {
+	dao, rep, clock := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90, "h": 90})
+	id, err := dao.OpenChallenge("h", "f", 20)
+	if err != nil || rep.GetReputation("h") != 70 || rep.LockedStake("h") != 20 {
+		t.Fatalf("OpenChallenge: %v, reputation %d, stake %d", err, rep.GetReputation("h"), rep.LockedStake("h"))
+	}
+	if _, err := dao.OpenChallenge("h", "g", 5); !errors.Is(err, ErrChallengePending) {
+		t.Fatalf("second challenge: got %v, want ErrChallengePending", err)
+	}
+	dao.Vote(id, "f", true, 1)
+	dao.Vote(id, "g", true, 1)
+	if !dao.Enact(id) || rep.GetReputation("h") != 70 || rep.LockedStake("h") != 0 {
+		t.Fatal("upheld challenge did not forfeit the stake")
+	}
+
+	dao.SetVotingPeriod(10)
+	id, _ = dao.OpenChallenge("h", "f", 10)
+	dao.Vote(id, "f", false, 1)
+	dao.Vote(id, "g", false, 1)
+	clock.now = 11
+	dao.Enact(id)
+	if rep.GetReputation("h") != 70 || dao.GetProposal(id).Status != StatusRejected {
+		t.Fatalf("rejected challenge: reputation %d, status %v", rep.GetReputation("h"), dao.GetProposal(id).Status)
+	}
+}
+
+func TestFailedChallengeLeavesNoTrace(t *testing.T) {
+	source := &stubSource{reputations: map[string]int{"f": 90, "h": 90}, refuseStakes: true}
+	dao := NewDAOContract(source, 0.5)
+	before, _ := dao.MarshalJSON()
+	if _, err := dao.OpenChallenge("h", "f", 20); !errors.Is(err, ErrInsufficientStake) {
+		t.Fatalf("got %v, want ErrInsufficientStake", err)
+	}
+	after, _ := dao.MarshalJSON()
+	if string(before) != string(after) {
+		t.Fatalf("refused challenge changed state:\n%s\n%s", before, after)
+	}
+	source.refuseStakes = false
+	id, err := dao.OpenChallenge("h", "f", 20)
+	if err != nil || id != "challenge-h-1" {
+		t.Fatalf("challenge after refusal: %q, %v", id, err)
+	}
+}
+
+func TestExpiredChallengeCannotBeReopened(t *testing.T) {
+	dao, rep, clock := newTestDAO(t, 0.5, map[string]int{"f": 81, "h": 90})
+	dao.AddAdmin("root")
+	dao.SetVotingPeriod(10)
+	id, _ := dao.OpenChallenge("h", "f", 20)
+	clock.now = 11
+	dao.Enact(id)
+	if dao.GetProposal(id).Status != StatusExpired || rep.LockedStake("h") != 0 {
+		t.Fatal("expired challenge kept its stake in escrow")
+	}
+	if dao.ReopenProposal(id, "root", 50) {
+		t.Fatal("reopened a challenge whose stake was already returned")
+	}
+}
@@ -0,0 +1,29 @@
+package reputation
+
+import "testing"
+
+func TestRevokeTokenRecordsReason(t *testing.T) {
+	rep := NewReputationContract()
+	rep.MintToken("a", 90)
+	before := len(rep.Events())
+	if rep.RevokeTokenWithReason("x", "admin", "why") {
+		t.Fatal("revoked an agent without a token")
+	}
+	if n := len(rep.Events()); n != before {
+		t.Fatalf("no-op revocation emitted %d events", n-before)
+	}
+	if !rep.RevokeTokenWithReason("a", "admin", "fraud") {
+		t.Fatal("revocation refused")
+	}
+	events := rep.Events()
+	last := events[len(events)-1]
+	if last.Type != EventTokenRevoked || last.Actor != "admin" || last.Subject != "a" {
+		t.Fatalf("revocation event = %+v", last)
+	}
+	if last.Details["reason"] != "fraud" || last.Details["prior_reputation"] != "90" {
+		t.Fatalf("revocation details = %v", last.Details)
+	}
+	if rep.HasToken("a") || rep.GetReputation("a") != 0 {
+		t.Fatal("revoked agent kept their token or reputation")
+	}
+}
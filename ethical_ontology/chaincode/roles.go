@@ -0,0 +1,68 @@
+package reputation
+
+// Role is a governance tier derived from reputation.
+type Role int
+
+const (
+	RoleObserver Role = iota
+	RoleMember
+	RoleElder
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleMember:
+		return "member"
+	case RoleElder:
+		return "elder"
+	default:
+		return "observer"
+	}
+}
+
+// RoleThresholds is the minimum reputation for each tier above observer.
+type RoleThresholds struct {
+	Member int
+	Elder  int
+}
+
+// DefaultRoleThresholds keeps the historical 30-reputation bar for proposing.
+var DefaultRoleThresholds = RoleThresholds{Member: 30, Elder: 90}
+
+// Actions the DAO gates by role.
+const (
+	ActionPropose   = "propose"
+	ActionChallenge = "challenge"
+)
+
+func (c *ReputationContract) SetRoleThresholds(t RoleThresholds) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SetRoleThresholds", t)
+	c.roleThresholds = t
+}
+
+func (c *ReputationContract) RoleFor(agentID string) Role {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	rep := c.reputations[agentID]
+	switch {
+	case rep >= c.roleThresholds.Elder:
+		return RoleElder
+	case rep >= c.roleThresholds.Member:
+		return RoleMember
+	default:
+		return RoleObserver
+	}
+}
+
+func (d *DAOContract) SetRequiredRole(action string, role Role) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetRequiredRole", action, role)
+	d.requiredRoles[action] = role
+}
+
+func (d *DAOContract) hasRole(agentID string, action string) bool {
+	return d.reputation.RoleFor(agentID) >= d.requiredRoles[action]
+}
@@ -0,0 +1,41 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRoleForTiers(t *testing.T) {
+	rep := NewReputationContract()
+	rep.MintToken("member", 81)
+	rep.MintToken("elder", 95)
+	rep.MintToken("observer", 81)
+	rep.Slash("observer", 60, "test")
+	for agentID, want := range map[string]Role{"member": RoleMember, "elder": RoleElder, "observer": RoleObserver, "nobody": RoleObserver} {
+		if got := rep.RoleFor(agentID); got != want {
+			t.Fatalf("RoleFor(%q) = %v, want %v", agentID, got, want)
+		}
+	}
+	rep.SetRoleThresholds(RoleThresholds{Member: 10, Elder: 80})
+	if got := rep.RoleFor("member"); got != RoleElder {
+		t.Fatalf("after lowering thresholds RoleFor = %v, want elder", got)
+	}
+}
+
+func TestProposingRequiresRole(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"member": 81, "elder": 95, "observer": 81})
+	rep.Slash("observer", 60, "test")
+	if err := dao.ProposeRuleChecked("p", "a valid description", "observer"); !errors.Is(err, ErrInsufficientRole) {
+		t.Fatalf("observer: got %v, want ErrInsufficientRole", err)
+	}
+	if err := dao.ProposeRuleChecked("p", "a valid description", "member"); err != nil {
+		t.Fatalf("member refused by default: %v", err)
+	}
+	dao.SetRequiredRole(ActionPropose, RoleElder)
+	if err := dao.ProposeRuleChecked("q", "a valid description", "member"); !errors.Is(err, ErrInsufficientRole) {
+		t.Fatalf("member under an elder requirement: got %v, want ErrInsufficientRole", err)
+	}
+	if err := dao.ProposeRuleChecked("q", "a valid description", "elder"); err != nil {
+		t.Fatalf("elder refused: %v", err)
+	}
+}
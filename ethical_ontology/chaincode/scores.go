@@ -0,0 +1,31 @@
+package reputation
+
+// ScoreRules is the valid range for virtue scores presented at minting.
+// Out-of-range scores are rejected unless Clamp is set, in which case they
+// are pulled to the nearest bound before the minting threshold applies.
+type ScoreRules struct {
+	Min   int
+	Max   int
+	Clamp bool
+}
+
+var DefaultScoreRules = ScoreRules{Min: 0, Max: 100}
+
+func (c *ReputationContract) SetScoreRules(rules ScoreRules) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SetScoreRules", rules)
+	c.scoreRules = rules
+}
+
+// normalizeScore returns score within the valid range, or false if it lies
+// outside and clamping is off.
+func (r ScoreRules) normalizeScore(score int) (int, bool) {
+	if score >= r.Min && score <= r.Max {
+		return score, true
+	}
+	if !r.Clamp {
+		return 0, false
+	}
+	return min(max(score, r.Min), r.Max), true
+}
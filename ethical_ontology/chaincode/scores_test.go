@@ -0,0 +1,29 @@
+package reputation
+
+import "testing"
+
+func TestScoreRulesValidateAndClamp(t *testing.T) {
+	rep := NewReputationContract()
+	if rep.MintToken("n", -5) || rep.MintToken("o", 150) {
+		t.Fatal("out-of-range score accepted by default")
+	}
+	if !rep.MintToken("i", 90) {
+		t.Fatal("in-range score refused")
+	}
+	rep.SetScoreRules(ScoreRules{Min: 0, Max: 100, Clamp: true})
+	if !rep.MintToken("o", 150) || rep.GetReputation("o") != 100 {
+		t.Fatalf("clamped mint: reputation %d, want 100", rep.GetReputation("o"))
+	}
+	// Clamped to zero, which is below the mint threshold
+	if rep.MintToken("n", -5) {
+		t.Fatal("negative score minted after clamping")
+	}
+	blob, _ := rep.MarshalJSON()
+	loaded := NewReputationContract()
+	if err := loaded.UnmarshalJSON(blob); err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.Config().ScoreRules.Clamp {
+		t.Fatal("score rules lost in serialization")
+	}
+}
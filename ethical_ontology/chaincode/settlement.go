@@ -0,0 +1,36 @@
+package reputation
+
+import "sort"
+
+// EnactResult reports what EnactAllReady did with one proposal. Err is nil
+// when the proposal was enacted and explains the refusal otherwise.
+type EnactResult struct {
+	ProposalID string
+	Status     ProposalStatus
+	Err        error
+}
+
+// EnactAllReady makes one settlement pass over the active proposals in ID
+// order, judging each as Enact would at now: passing proposals are enacted,
+// and failing ones past their deadline are rejected or expired. Proposals
+// still open and not passing are reported but left untouched, and a proposal
+// whose conflict passed earlier in the pass is refused.
+func (d *DAOContract) EnactAllReady(now int) []EnactResult {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("EnactAllReady", now)
+	ids := make([]string, 0, len(d.proposals))
+	for id, prop := range d.proposals {
+		if prop.Active {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	results := make([]EnactResult, 0, len(ids))
+	for _, id := range ids {
+		prop := d.proposals[id]
+		err := d.enact(prop, now)
+		results = append(results, EnactResult{ProposalID: id, Status: prop.Status, Err: err})
+	}
+	return results
+}
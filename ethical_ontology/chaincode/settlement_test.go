@@ -0,0 +1,35 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEnactAllReady(t *testing.T) {
+	dao, _, clock := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90})
+	clock.now = 100
+	dao.SetVotingPeriod(50)
+	dao.ProposeRule("a", "a valid description", "f")
+	dao.ProposeRule("b", "b valid description", "g")
+	dao.ProposeRule("c", "c valid description", "g")
+	dao.Vote("a", "f", true, 1)
+	dao.Vote("a", "g", true, 1)
+	dao.Vote("b", "g", false, 1) // f could still outvote g
+
+	results := dao.EnactAllReady(120)
+	if len(results) != 3 || results[0].ProposalID != "a" || results[0].Err != nil || results[0].Status != StatusPassed {
+		t.Fatalf("first pass: %+v", results)
+	}
+	// Failing but still open: reported and left alone
+	if !errors.Is(results[1].Err, ErrNotPassing) || results[1].Status != StatusActive {
+		t.Fatalf("open failing proposal: %+v", results[1])
+	}
+
+	results = dao.EnactAllReady(200)
+	if len(results) != 2 || results[0].Status != StatusRejected || results[1].Status != StatusExpired {
+		t.Fatalf("after the deadline: %+v", results)
+	}
+	if results := dao.EnactAllReady(300); len(results) != 0 {
+		t.Fatalf("settled proposals revisited: %+v", results)
+	}
+}
@@ -0,0 +1,123 @@
+package reputation
+
+import "sort"
+
+// Scenario describes a synthetic DAO for SimulateScenario. Every agent holds
+// a token with the given reputation. Delegations are applied first, then the
+// proposals are created and the votes cast in order, all at time zero;
+// voting then closes and each proposal is enacted.
+type Scenario struct {
+	Quorum      float64
+	Agents      map[string]int    // agentID -> reputation
+	Delegations map[string]string // delegator -> delegate
+	Proposals   []ScenarioProposal
+	Votes       []PlannedVote
+	// Configure, if set, adjusts the throwaway DAO before anything happens,
+	// for instance to try a different enactment policy or delegation cap.
+	Configure func(dao *DAOContract)
+}
+
+type ScenarioProposal struct {
+	ID          string
+	Description string
+	ProposerID  string
+}
+
+type PlannedVote struct {
+	ProposalID string
+	AgentID    string
+	Choice     VoteChoice
+	Weight     int
+}
+
+// ScenarioOutcome is what became of one scenario proposal. Err holds the
+// reason it could not be created or enacted.
+type ScenarioOutcome struct {
+	ProposalID string
+	Status     ProposalStatus
+	Results    ProposalResults
+	Err        error
+}
+
+type ScenarioResult struct {
+	Outcomes   []ScenarioOutcome // in scenario order
+	VoteErrors []error           // aligned with Scenario.Votes; nil where the vote was accepted
+	// PowerGini is the Gini coefficient of effective voting power after
+	// delegation: 0 when power is spread evenly, approaching 1 as it
+	// concentrates in one agent.
+	PowerGini float64
+}
+
+// SimulateScenario plays scenario through the real Vote and Enact code on
+// throwaway contracts, so the outcome is what production would decide.
+func SimulateScenario(scenario Scenario) ScenarioResult {
+	clock := &replayClock{}
+	rep := NewReputationContract()
+	rep.SetClock(clock)
+	rep.SetReputationCap(0)
+	agents := make([]string, 0, len(scenario.Agents))
+	for agentID := range scenario.Agents {
+		agents = append(agents, agentID)
+	}
+	sort.Strings(agents)
+	for _, agentID := range agents {
+		// Seeded directly: synthetic agents need not pass the minting rules
+		rep.tokens[agentID] = true
+		rep.setReputation(agentID, scenario.Agents[agentID])
+	}
+	dao := NewDAOContract(rep, scenario.Quorum)
+	dao.SetClock(clock)
+	dao.SetVotingPeriod(1)
+	if scenario.Configure != nil {
+		scenario.Configure(dao)
+	}
+	delegators := make([]string, 0, len(scenario.Delegations))
+	for delegator := range scenario.Delegations {
+		delegators = append(delegators, delegator)
+	}
+	sort.Strings(delegators)
+	for _, delegator := range delegators {
+		dao.Delegate(delegator, scenario.Delegations[delegator])
+	}
+	var result ScenarioResult
+	result.PowerGini = gini(dao.DelegationResolvedPower(clock.Now()))
+	for _, proposal := range scenario.Proposals {
+		result.Outcomes = append(result.Outcomes, ScenarioOutcome{
+			ProposalID: proposal.ID,
+			Err:        dao.ProposeRuleChecked(proposal.ID, proposal.Description, proposal.ProposerID),
+		})
+	}
+	for _, vote := range scenario.Votes {
+		result.VoteErrors = append(result.VoteErrors, dao.VoteChecked(vote.ProposalID, vote.AgentID, vote.Choice, vote.Weight))
+	}
+	clock.now = 1
+	for i := range result.Outcomes {
+		outcome := &result.Outcomes[i]
+		if outcome.Err != nil {
+			continue
+		}
+		outcome.Err = dao.EnactChecked(outcome.ProposalID)
+		prop, _ := dao.lookup(outcome.ProposalID)
+		outcome.Status = prop.Status
+		outcome.Results, _ = dao.GetProposalResults(outcome.ProposalID)
+	}
+	return result
+}
+
+// gini measures how unevenly power is spread across agents.
+func gini(power map[string]float64) float64 {
+	values := make([]float64, 0, len(power))
+	for _, p := range power {
+		values = append(values, max(p, 0))
+	}
+	sort.Float64s(values)
+	total, weighted := 0.0, 0.0
+	for i, v := range values {
+		total += v
+		weighted += float64(2*(i+1)-len(values)-1) * v
+	}
+	if total == 0 {
+		return 0
+	}
+	return weighted / (float64(len(values)) * total)
+}
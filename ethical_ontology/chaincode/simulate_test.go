@@ -0,0 +1,70 @@
+package reputation
+
+import "testing"
+
+var simulationScenario = Scenario{
+	Quorum:      0.5,
+	Agents:      map[string]int{"a": 90, "b": 40, "c": 60, "d": 10},
+	Delegations: map[string]string{"d": "b"},
+	Proposals: []ScenarioProposal{
+		{"p", "a valid description", "a"},
+		{"q", "b valid description", "c"},
+	},
+	Votes: []PlannedVote{
+		{"p", "a", VoteFor, 1},
+		{"p", "b", VoteAgainst, 2},
+		{"q", "c", VoteFor, 1},
+		{"q", "a", VoteAgainst, 3},
+		{"p", "d", VoteFor, 1}, // delegated away
+	},
+}
+
+func TestSimulationMatchesLiveContracts(t *testing.T) {
+	s := simulationScenario
+	result := SimulateScenario(s)
+
+	// The same scenario by hand. Tokens are granted directly because most of
+	// these reputations are below the mint threshold.
+	rep := NewReputationContract()
+	rep.SetReputationCap(0)
+	for agentID, score := range s.Agents {
+		rep.tokens[agentID] = true
+		rep.setReputation(agentID, score)
+	}
+	clock := &manualClock{}
+	dao := NewDAOContract(rep, s.Quorum)
+	dao.SetClock(clock)
+	dao.SetVotingPeriod(1)
+	dao.Delegate("d", "b")
+	for _, p := range s.Proposals {
+		dao.ProposeRule(p.ID, p.Description, p.ProposerID)
+	}
+	for _, v := range s.Votes {
+		dao.VoteChecked(v.ProposalID, v.AgentID, v.Choice, v.Weight)
+	}
+	clock.now = 1
+	for _, p := range s.Proposals {
+		dao.Enact(p.ID)
+	}
+
+	for _, outcome := range result.Outcomes {
+		if status := dao.GetProposal(outcome.ProposalID).Status; status != outcome.Status {
+			t.Fatalf("%s: simulated %v, live %v", outcome.ProposalID, outcome.Status, status)
+		}
+		if live, _ := dao.GetProposalResults(outcome.ProposalID); live != outcome.Results {
+			t.Fatalf("%s: simulated %+v, live %+v", outcome.ProposalID, outcome.Results, live)
+		}
+	}
+	if result.VoteErrors[0] != nil || result.VoteErrors[4] == nil {
+		t.Fatalf("vote errors %v, want only the delegated vote refused", result.VoteErrors)
+	}
+}
+
+func TestPowerGini(t *testing.T) {
+	if g := SimulateScenario(simulationScenario).PowerGini; g <= 0 || g >= 1 {
+		t.Fatalf("Gini %v for uneven power, want strictly between 0 and 1", g)
+	}
+	if g := gini(map[string]float64{"a": 5, "b": 5}); g != 0 {
+		t.Fatalf("Gini %v for equal power, want 0", g)
+	}
+}
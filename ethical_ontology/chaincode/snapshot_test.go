@@ -0,0 +1,62 @@
+package reputation
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// TestConcurrentVotesAndSnapshots is meant for go test -race: readers take
+// snapshots while voters write.
+func TestConcurrentVotesAndSnapshots(t *testing.T) {
+	scores := map[string]int{}
+	for i := 0; i < 50; i++ {
+		scores[fmt.Sprint("agent", i)] = 90
+	}
+	dao, rep, _ := newTestDAO(t, 0.5, scores)
+	dao.ProposeRule("p", "a valid description", "agent0")
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(agentID string) {
+			defer wg.Done()
+			dao.Vote("p", agentID, true, 1)
+		}(fmt.Sprint("agent", i))
+	}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				for _, prop := range dao.SnapshotProposals() {
+					_ = prop.VotesFor
+					for agentID := range prop.Voters {
+						_ = prop.Ballots[agentID]
+					}
+				}
+				_ = dao.Events()
+				_ = rep.GetReputation("agent0")
+			}
+		}()
+	}
+	wg.Wait()
+	if voters := len(dao.SnapshotProposals()[0].Voters); voters != 50 {
+		t.Fatalf("%d voters recorded, want 50", voters)
+	}
+}
+
+func TestSnapshotIsDetached(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90})
+	dao.ProposeRule("p", "a valid description", "a")
+	dao.Vote("p", "a", true, 1)
+	snapshot := dao.SnapshotProposals()[0]
+	snapshot.Voters["b"] = true
+	snapshot.Tags = append(snapshot.Tags, "x")
+	dao.Vote("p", "b", false, 1)
+	if live := dao.GetProposal("p"); len(live.Tags) != 0 || live.Ballots["b"].Choice != VoteAgainst {
+		t.Fatalf("writing to a snapshot reached the live proposal: %+v", live)
+	}
+	if snapshot.VotesAgainst != 0 {
+		t.Fatal("snapshot saw a later vote")
+	}
+}
@@ -0,0 +1,112 @@
+package reputation
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SetSoulbound controls whether tokens and reputation are bound to the agent
+// they were minted for. It is on by default; when on, TransferReputation is
+// refused and MergeAccounts leaves tokens where they are.
+func (c *ReputationContract) SetSoulbound(soulbound bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SetSoulbound", soulbound)
+	c.soulbound = soulbound
+}
+
+// TransferReputation moves amount of fromID's reputation to toID, who must
+// hold a token, without dipping below fromID's protected floor. It fails
+// with ErrSoulbound in soulbound mode.
+func (c *ReputationContract) TransferReputation(fromID string, toID string, amount int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("TransferReputation", fromID, toID, amount)
+	if c.soulbound {
+		return ErrSoulbound
+	}
+	if c.quarantined[fromID] || c.quarantined[toID] {
+		return ErrQuarantined
+	}
+	switch {
+	case fromID == toID:
+		return fmt.Errorf("%w: sender and recipient are both %q", ErrInvalidTransfer, fromID)
+	case !c.tokens[toID]:
+		return fmt.Errorf("%w: %q holds no token", ErrInvalidTransfer, toID)
+	case amount <= 0 || amount > c.reputations[fromID]:
+		return fmt.Errorf("%w: %d is outside %q's balance of %d", ErrInvalidTransfer, amount, fromID, c.reputations[fromID])
+	case c.reputations[fromID]-amount < c.floors[fromID]:
+		return fmt.Errorf("%w: %q is protected by a floor of %d", ErrInvalidTransfer, fromID, c.floors[fromID])
+	case c.reputationCap > 0 && c.reputations[toID]+amount > c.reputationCap:
+		return fmt.Errorf("%w: %q would exceed the reputation cap", ErrInvalidTransfer, toID)
+	}
+	c.setReputation(fromID, c.reputations[fromID]-amount)
+	c.setReputation(toID, c.reputations[toID]+amount)
+	c.emit(Event{
+		Type:    EventReputationTransferred,
+		Subject: toID,
+		Actor:   fromID,
+		Details: map[string]string{"amount": strconv.Itoa(amount)},
+	})
+	return nil
+}
+
+// SetRecoveryAuthority names the agent allowed to merge accounts. Empty,
+// the default, disables MergeAccounts.
+func (c *ReputationContract) SetRecoveryAuthority(agentID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("SetRecoveryAuthority", agentID)
+	c.recoveryAuthority = agentID
+}
+
+// MergeAccounts folds fromID's reputation into intoID, for agents
+// consolidating duplicate identities; only the recovery authority may do
+// it. Outside soulbound mode fromID's token moves too if intoID has none; in
+// soulbound mode it stays with fromID. Merges that would strand escrowed
+// stakes, empty a floor-protected account or lift intoID over the
+// reputation cap are refused.
+func (c *ReputationContract) MergeAccounts(fromID string, intoID string, authorityID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.record("MergeAccounts", fromID, intoID, authorityID)
+	if c.recoveryAuthority == "" || authorityID != c.recoveryAuthority {
+		return ErrNotRecoveryAuthority
+	}
+	if fromID == intoID {
+		return fmt.Errorf("%w: cannot merge %q into itself", ErrInvalidTransfer, fromID)
+	}
+	if c.quarantined[fromID] || c.quarantined[intoID] {
+		return ErrQuarantined
+	}
+	merged := c.reputations[fromID]
+	switch {
+	case c.stakes[fromID] > 0 || c.stakes[intoID] > 0:
+		return fmt.Errorf("%w: stakes are locked in escrow", ErrInvalidTransfer)
+	case c.floors[fromID] > 0:
+		return fmt.Errorf("%w: %q is protected by a floor of %d", ErrInvalidTransfer, fromID, c.floors[fromID])
+	case c.reputationCap > 0 && c.reputations[intoID]+merged > c.reputationCap:
+		return fmt.Errorf("%w: %q would exceed the reputation cap", ErrInvalidTransfer, intoID)
+	}
+	c.setReputation(intoID, c.reputations[intoID]+merged)
+	c.deleteReputation(fromID)
+	tokenMoved := !c.soulbound && c.tokens[fromID] && !c.tokens[intoID]
+	if tokenMoved {
+		delete(c.tokens, fromID)
+		c.tokens[intoID] = true
+	}
+	if c.tokens[fromID] {
+		// The bound token keeps its holder on the ledger
+		c.setReputation(fromID, 0)
+	}
+	c.emit(Event{
+		Type:    EventAccountsMerged,
+		Subject: intoID,
+		Actor:   fromID,
+		Details: map[string]string{
+			"reputation":  strconv.Itoa(merged),
+			"token_moved": strconv.FormatBool(tokenMoved),
+		},
+	})
+	return nil
+}
@@ -0,0 +1,74 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func newSoulboundTestLedger(t *testing.T) *ReputationContract {
+	t.Helper()
+	rep := NewReputationContract()
+	for agentID, score := range map[string]int{"f": 81, "g": 90, "x": 85} {
+		rep.MintToken(agentID, score)
+	}
+	rep.SetRecoveryAuthority("root")
+	return rep
+}
+
+func TestSoulboundBlocksTransfers(t *testing.T) {
+	rep := newSoulboundTestLedger(t)
+	if err := rep.TransferReputation("f", "g", 5); !errors.Is(err, ErrSoulbound) {
+		t.Fatalf("transfer in soulbound mode: got %v, want ErrSoulbound", err)
+	}
+	if err := rep.MergeAccounts("x", "y", "root"); err != nil {
+		t.Fatal(err)
+	}
+	if rep.GetReputation("y") != 85 || !rep.HasToken("x") || rep.HasToken("y") {
+		t.Fatal("soulbound merge should move reputation but leave the token")
+	}
+
+	rep.SetSoulbound(false)
+	if err := rep.TransferReputation("g", "f", 5); err != nil {
+		t.Fatal(err)
+	}
+	if rep.GetReputation("f") != 86 {
+		t.Fatalf("f holds %d after transfer, want 86", rep.GetReputation("f"))
+	}
+	if err := rep.TransferReputation("g", "f", 500); !errors.Is(err, ErrInvalidTransfer) {
+		t.Fatalf("overdrawn transfer: got %v", err)
+	}
+	if err := rep.MergeAccounts("x", "z", "root"); err != nil {
+		t.Fatal(err)
+	}
+	if !rep.HasToken("z") || rep.HasToken("x") {
+		t.Fatal("token should move with a merge outside soulbound mode")
+	}
+}
+
+func TestMergeAccountsKeepsProtections(t *testing.T) {
+	rep := newSoulboundTestLedger(t)
+	if err := rep.MergeAccounts("x", "g", "f"); !errors.Is(err, ErrNotRecoveryAuthority) {
+		t.Fatalf("merge by a non-authority: got %v", err)
+	}
+	if err := rep.MergeAccounts("x", "g", "root"); !errors.Is(err, ErrInvalidTransfer) {
+		t.Fatalf("merge over the reputation cap: got %v", err)
+	}
+
+	rep.SetReputationFloor("f", 50)
+	if err := rep.MergeAccounts("f", "new", "root"); !errors.Is(err, ErrInvalidTransfer) {
+		t.Fatalf("merge of a floor-protected account: got %v", err)
+	}
+	if rep.GetReputation("f") != 81 {
+		t.Fatalf("refused merge changed f to %d", rep.GetReputation("f"))
+	}
+
+	rep.LockStake("x", 10)
+	if err := rep.MergeAccounts("x", "new", "root"); !errors.Is(err, ErrInvalidTransfer) {
+		t.Fatalf("merge with a locked stake: got %v", err)
+	}
+
+	rep.SetSoulbound(false)
+	if err := rep.TransferReputation("f", "g", 40); !errors.Is(err, ErrInvalidTransfer) {
+		t.Fatalf("transfer below the floor: got %v", err)
+	}
+}
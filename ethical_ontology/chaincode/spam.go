@@ -0,0 +1,172 @@
+package reputation
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SpamRules tunes SpamScore. Each signal fades to zero as the proposer or
+// description reaches the corresponding level; a zero level disables it.
+type SpamRules struct {
+	EstablishedReputation int     // proposer reputation with no spam signal
+	DetailedLength        int     // description length, in characters, with no spam signal
+	RecentProposals       int     // how many of the proposer's earlier proposals feed the rejection rate
+	FlagThreshold         float64 // new proposals scoring at least this join the review queue; zero disables
+}
+
+var DefaultSpamRules = SpamRules{
+	EstablishedReputation: 80,
+	DetailedLength:        200,
+	RecentProposals:       10,
+}
+
+// fluentEntropy approximates the bits per character of ordinary prose;
+// descriptions at or above it carry no entropy signal.
+const fluentEntropy = 4.0
+
+func (d *DAOContract) SetSpamRules(rules SpamRules) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetSpamRules", rules)
+	d.spamRules = rules
+}
+
+// SpamScore rates how likely a proposal is to be spam, from 0 to 1, as the
+// mean of four signals: low proposer reputation, a short description, a
+// repetitive (low-entropy) description, and the share of the proposer's
+// recent decided proposals that failed. It is a triage aid only and
+// changes nothing.
+func (d *DAOContract) SpamScore(proposalID string) float64 {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	prop, exists := d.lookup(proposalID)
+	if !exists {
+		return 0
+	}
+	return d.spamScore(prop)
+}
+
+func (d *DAOContract) spamScore(prop *Proposal) float64 {
+	rules := d.spamRules
+	description := strings.TrimSpace(prop.Description)
+	signals := []float64{
+		shortfall(float64(d.reputation.GetReputation(prop.ProposerID)), float64(rules.EstablishedReputation)),
+		shortfall(float64(utf8.RuneCountInString(description)), float64(rules.DetailedLength)),
+		shortfall(entropy(description), fluentEntropy),
+		d.recentRejectionRate(prop),
+	}
+	total := 0.0
+	for _, signal := range signals {
+		total += signal
+	}
+	return total / float64(len(signals))
+}
+
+// shortfall is how far value falls short of target, as a fraction of it.
+func shortfall(value float64, target float64) float64 {
+	if target <= 0 {
+		return 0
+	}
+	return 1 - min(max(value/target, 0), 1)
+}
+
+// entropy is the Shannon entropy of s in bits per character.
+func entropy(s string) float64 {
+	counts := make(map[rune]int)
+	n := 0
+	for _, ch := range s {
+		counts[ch]++
+		n++
+	}
+	// Summed in rune order so the float result never depends on map order
+	runes := make([]rune, 0, len(counts))
+	for ch := range counts {
+		runes = append(runes, ch)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	bits := 0.0
+	for _, ch := range runes {
+		p := float64(counts[ch]) / float64(n)
+		bits -= p * math.Log2(p)
+	}
+	return bits
+}
+
+// recentRejectionRate is the fraction of the proposer's last few decided
+// proposals, made before prop, that were rejected or expired.
+func (d *DAOContract) recentRejectionRate(prop *Proposal) float64 {
+	var earlier []*Proposal
+	for _, other := range d.proposals {
+		if other.ProposerID == prop.ProposerID && other.Sequence < prop.Sequence {
+			earlier = append(earlier, other)
+		}
+	}
+	sort.Slice(earlier, func(i, j int) bool { return earlier[i].Sequence > earlier[j].Sequence })
+	if len(earlier) > d.spamRules.RecentProposals {
+		earlier = earlier[:max(d.spamRules.RecentProposals, 0)]
+	}
+	decided, failed := 0, 0
+	for _, other := range earlier {
+		switch other.Status {
+		case StatusRejected, StatusExpired:
+			failed++
+			decided++
+		case StatusPassed, StatusDisputed:
+			decided++
+		}
+	}
+	if decided == 0 {
+		return 0
+	}
+	return float64(failed) / float64(decided)
+}
+
+// flagIfSpam puts a new proposal in the review queue when it scores at or
+// above the flag threshold. Flagged proposals stay open to voting.
+func (d *DAOContract) flagIfSpam(prop *Proposal) {
+	if d.spamRules.FlagThreshold <= 0 {
+		return
+	}
+	score := d.spamScore(prop)
+	if score < d.spamRules.FlagThreshold {
+		return
+	}
+	prop.FlaggedForReview = true
+	d.emit(Event{
+		Type:    EventProposalFlagged,
+		Subject: prop.ID,
+		Actor:   prop.ProposerID,
+		Details: map[string]string{"spam_score": strconv.FormatFloat(score, 'f', 4, 64)},
+	})
+}
+
+// ReviewQueue lists the flagged proposals still awaiting a moderator,
+// sorted by ID.
+func (d *DAOContract) ReviewQueue() []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var queue []string
+	for id, prop := range d.proposals {
+		if prop.FlaggedForReview {
+			queue = append(queue, id)
+		}
+	}
+	sort.Strings(queue)
+	return queue
+}
+
+// DismissReview lets an admin clear a proposal from the review queue.
+func (d *DAOContract) DismissReview(proposalID string, adminID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("DismissReview", proposalID, adminID)
+	prop, exists := d.lookup(proposalID)
+	if !exists || !prop.FlaggedForReview || !d.admins[adminID] {
+		return false
+	}
+	prop.FlaggedForReview = false
+	return true
+}
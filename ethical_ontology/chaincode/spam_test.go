@@ -0,0 +1,49 @@
+package reputation
+
+import "testing"
+
+const carefulDescription = "Require two independent reviewers to sign off on every rule change affecting fairness audits, with a 7-day comment window."
+
+func TestSpamScoreRanksJunkHigher(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"vet": 95, "new": 81})
+	rep.Slash("new", 20, "abuse")
+	dao.SetDescriptionRules(DescriptionRules{MinLength: 1})
+	dao.ProposeRule("good", carefulDescription, "vet")
+	if !dao.ProposeRule("junk", "aaaaaaaaaa", "new") {
+		t.Fatal("scoring must not block proposals")
+	}
+	good, junk := dao.SpamScore("good"), dao.SpamScore("junk")
+	if junk <= good {
+		t.Fatalf("junk scored %v, careful proposal %v", junk, good)
+	}
+	if dao.SpamScore("junk") != junk {
+		t.Fatal("score is not stable")
+	}
+}
+
+func TestReviewQueue(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"vet": 95, "new": 81})
+	rep.Slash("new", 20, "abuse")
+	dao.SetDescriptionRules(DescriptionRules{MinLength: 1})
+	rules := DefaultSpamRules
+	rules.FlagThreshold = 0.5
+	dao.SetSpamRules(rules)
+	dao.ProposeRule("junk", "bbbbbbbbbb", "new")
+	dao.ProposeRule("good", carefulDescription, "vet")
+	if queue := dao.ReviewQueue(); len(queue) != 1 || queue[0] != "junk" {
+		t.Fatalf("review queue %v, want [junk]", queue)
+	}
+	if dao.DismissReview("junk", "vet") {
+		t.Fatal("non-admin dismissed a review")
+	}
+	dao.AddAdmin("adm")
+	if !dao.DismissReview("junk", "adm") || len(dao.ReviewQueue()) != 0 {
+		t.Fatal("admin could not dismiss the review")
+	}
+	if !dao.Vote("junk", "vet", true, 1) {
+		t.Fatal("flagged proposal must still accept votes")
+	}
+	if _, _, err := ReplayOperations(dao.OperationLog()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,91 @@
+package reputation
+
+import "math"
+
+// DelegateSplit delegates fractions of fromAgentID's own reputation to
+// several delegates at once. Fractions must be positive and sum to at most
+// one; the remainder stays with fromAgentID, who may still vote with it. A
+// split replaces any single delegation, and an empty split removes it.
+func (d *DAOContract) DelegateSplit(fromAgentID string, splits map[string]float64) bool {
+	return d.DelegateSplitChecked(fromAgentID, splits) == nil
+}
+
+// DelegateSplitChecked is DelegateSplit reporting why a split was refused.
+func (d *DAOContract) DelegateSplitChecked(fromAgentID string, splits map[string]float64) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("DelegateSplitChecked", fromAgentID, splits)
+	sum := 0.0
+	for to, fraction := range splits {
+		if to == fromAgentID {
+			return ErrSelfDelegation
+		}
+		if !(fraction > 0) || math.IsInf(fraction, 0) {
+			return ErrInvalidSplit
+		}
+		sum += fraction
+		if d.reputation.IsQuarantined(to) {
+			return ErrQuarantined
+		}
+	}
+	// Allow for float rounding in splits such as thirds
+	if sum > 1+1e-9 {
+		return ErrInvalidSplit
+	}
+	if d.reputation.IsQuarantined(fromAgentID) {
+		return ErrQuarantined
+	}
+	if d.reputation.GetReputation(fromAgentID) < d.minDelegation {
+		return ErrDelegatorReputation
+	}
+	if d.delegationInUse(fromAgentID) {
+		return ErrDelegationInUse
+	}
+	previous, hadPrevious := d.splits[fromAgentID]
+	delete(d.splits, fromAgentID)
+	if limit, capped := d.delegationLimit(); capped {
+		incoming := make(map[string]int)
+		for to, share := range d.splitShares(fromAgentID, splits) {
+			incoming[d.resolveDelegate(to)] += share
+		}
+		for final, share := range incoming {
+			if d.delegatedPower(final, nil)+share > limit {
+				if hadPrevious {
+					d.splits[fromAgentID] = previous
+				}
+				return ErrDelegationCap
+			}
+		}
+	}
+	if len(splits) > 0 {
+		copied := make(map[string]float64, len(splits))
+		for to, fraction := range splits {
+			copied[to] = fraction
+		}
+		d.splits[fromAgentID] = copied
+	}
+	delete(d.delegations, fromAgentID)
+	d.logger.Info("delegated_split", "from", fromAgentID, "delegates", len(splits), "fraction", sum)
+	return nil
+}
+
+// splitShares converts a delegator's fractions into whole reputation,
+// truncating so the shares never exceed what the delegator holds.
+func (d *DAOContract) splitShares(fromAgentID string, splits map[string]float64) map[string]int {
+	rep := d.reputation.GetReputation(fromAgentID)
+	shares := make(map[string]int, len(splits))
+	for to, fraction := range splits {
+		shares[to] = int(float64(rep) * fraction)
+	}
+	return shares
+}
+
+// ownReputation is the reputation agentID votes with themselves: all of it
+// unless part has been split off to delegates.
+func (d *DAOContract) ownReputation(agentID string) int {
+	rep := d.reputation.GetReputation(agentID)
+	for _, share := range d.splitShares(agentID, d.splits[agentID]) {
+		rep -= share
+	}
+	return rep
+}
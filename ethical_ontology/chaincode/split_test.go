@@ -0,0 +1,37 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSplitDelegation(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 100, "a": 90, "b": 90})
+	if err := dao.DelegateSplitChecked("f", map[string]float64{"a": 0.7, "b": 0.4}); !errors.Is(err, ErrInvalidSplit) {
+		t.Fatalf("fractions over one: got %v, want ErrInvalidSplit", err)
+	}
+	if !dao.DelegateSplit("f", map[string]float64{"a": 0.6, "b": 0.3}) {
+		t.Fatal("valid split refused")
+	}
+	dao.ProposeRule("p", "a valid description", "a")
+	dao.Vote("p", "a", true, 1)
+	dao.Vote("p", "b", true, 1)
+	// The delegator keeps and votes with the undelegated tenth
+	dao.Vote("p", "f", true, 1)
+	ballots := dao.GetProposal("p").Ballots
+	if ballots["a"].Reputation != 150 || ballots["b"].Reputation != 120 || ballots["f"].Reputation != 10 {
+		t.Fatalf("ballot reputations a=%d b=%d f=%d", ballots["a"].Reputation, ballots["b"].Reputation, ballots["f"].Reputation)
+	}
+}
+
+func TestSingleDelegationReplacesSplit(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 100, "a": 90, "b": 90})
+	dao.DelegateSplit("f", map[string]float64{"a": 0.6, "b": 0.3})
+	if !dao.Delegate("f", "a") {
+		t.Fatal("Delegate refused")
+	}
+	power := dao.DelegationResolvedPower(0)
+	if power["a"] != 190 || power["b"] != 90 {
+		t.Fatalf("after replacing the split: %v", power)
+	}
+}
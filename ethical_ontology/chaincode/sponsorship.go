@@ -0,0 +1,38 @@
+package reputation
+
+import "slices"
+
+// TransferSponsorship hands an undecided proposal from its proposer,
+// fromAgentID, to toAgentID, who must be allowed to propose and not be
+// quarantined. The new sponsor takes over every right keyed to ProposerID,
+// such as amending; a co-signer who takes over leaves the co-signer list.
+// When self-voting is disallowed, an agent who already voted cannot take
+// over.
+func (d *DAOContract) TransferSponsorship(proposalID string, fromAgentID string, toAgentID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("TransferSponsorship", proposalID, fromAgentID, toAgentID)
+	prop, exists := d.lookup(proposalID)
+	if !exists || (!prop.Active && prop.Status != StatusAwaitingSponsors) {
+		return false
+	}
+	if prop.ProposerID != fromAgentID || toAgentID == fromAgentID {
+		return false
+	}
+	if !d.hasRole(toAgentID, ActionPropose) || d.reputation.IsQuarantined(toAgentID) {
+		return false
+	}
+	if d.selfVoteDisallowed && prop.Voters[toAgentID] {
+		return false
+	}
+	prop.ProposerID = toAgentID
+	prop.Cosigners = slices.DeleteFunc(prop.Cosigners, func(agentID string) bool { return agentID == toAgentID })
+	d.emit(Event{
+		Type:    EventSponsorshipTransferred,
+		Subject: prop.ID,
+		Actor:   fromAgentID,
+		Details: map[string]string{"to": toAgentID},
+	})
+	d.logger.Info("sponsorship_transferred", "proposal", prop.ID, "from", fromAgentID, "to", toAgentID)
+	return true
+}
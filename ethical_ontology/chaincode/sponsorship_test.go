@@ -0,0 +1,29 @@
+package reputation
+
+import "testing"
+
+func TestTransferSponsorship(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90, "low": 81})
+	rep.Slash("low", 70, "misconduct")
+	dao.ProposeRule("p", "a valid description", "a")
+	if dao.TransferSponsorship("p", "a", "low") {
+		t.Fatal("transferred to an agent below the proposal threshold")
+	}
+	if dao.TransferSponsorship("p", "b", "b") || dao.TransferSponsorship("p", "b", "a") {
+		t.Fatal("someone other than the sponsor moved sponsorship")
+	}
+	if !dao.TransferSponsorship("p", "a", "b") || dao.GetProposal("p").ProposerID != "b" {
+		t.Fatal("sponsor could not hand over")
+	}
+	if !dao.AmendProposal("p", "b", "reworded description") || dao.AmendProposal("p", "a", "another description") {
+		t.Fatal("amendment rights did not move with sponsorship")
+	}
+	dao.Vote("p", "a", true, 1)
+	dao.Enact("p")
+	if dao.TransferSponsorship("p", "b", "a") {
+		t.Fatal("transferred a finalized proposal")
+	}
+	if _, _, err := ReplayOperations(dao.OperationLog()); err != nil {
+		t.Fatal(err)
+	}
+}
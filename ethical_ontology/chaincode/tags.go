@@ -0,0 +1,76 @@
+package reputation
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// AddTag labels an active proposal with tag, lowercased and trimmed. Adding
+// a tag the proposal already carries succeeds without duplicating it.
+func (d *DAOContract) AddTag(proposalID string, tag string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("AddTag", proposalID, tag)
+	prop, exists := d.lookup(proposalID)
+	tag = normalizeTag(tag)
+	if !exists || !prop.Active || tag == "" {
+		return false
+	}
+	if i, found := slices.BinarySearch(prop.Tags, tag); !found {
+		prop.Tags = slices.Insert(prop.Tags, i, tag)
+	}
+	return true
+}
+
+// RemoveTag drops tag from an active proposal, reporting whether it was there.
+func (d *DAOContract) RemoveTag(proposalID string, tag string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("RemoveTag", proposalID, tag)
+	prop, exists := d.lookup(proposalID)
+	if !exists || !prop.Active {
+		return false
+	}
+	i, found := slices.BinarySearch(prop.Tags, normalizeTag(tag))
+	if !found {
+		return false
+	}
+	prop.Tags = slices.Delete(prop.Tags, i, i+1)
+	return true
+}
+
+// ProposalsByTags returns copies of the proposals carrying every tag
+// (matchAll) or any of them, ordered by ID. An empty tag list matches nothing.
+func (d *DAOContract) ProposalsByTags(tags []string, matchAll bool) []*Proposal {
+	wanted := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = normalizeTag(tag); tag != "" {
+			wanted = append(wanted, tag)
+		}
+	}
+	if len(wanted) == 0 {
+		return nil
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var matches []*Proposal
+	for _, prop := range d.proposals {
+		hits := 0
+		for _, tag := range wanted {
+			if _, found := slices.BinarySearch(prop.Tags, tag); found {
+				hits++
+			}
+		}
+		if (matchAll && hits == len(wanted)) || (!matchAll && hits > 0) {
+			c := prop.clone()
+			matches = append(matches, &c)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+	return matches
+}
@@ -0,0 +1,25 @@
+package reputation
+
+import "testing"
+
+func TestProposalTags(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81})
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.ProposeRule("q", "a valid description 2", "f")
+	dao.AddTag("p", " Treasury ")
+	dao.AddTag("p", "treasury")
+	dao.AddTag("p", "SECURITY")
+	dao.AddTag("q", "security")
+	if tags := dao.GetProposal("p").Tags; len(tags) != 2 || tags[0] != "security" || tags[1] != "treasury" {
+		t.Fatalf("tags = %v, want normalized, deduplicated and sorted", tags)
+	}
+	if got := dao.ProposalsByTags([]string{"treasury", "security"}, true); len(got) != 1 {
+		t.Fatalf("matching all tags: %d proposals, want 1", len(got))
+	}
+	if got := dao.ProposalsByTags([]string{"treasury", "Security"}, false); len(got) != 2 {
+		t.Fatalf("matching any tag: %d proposals, want 2", len(got))
+	}
+	if !dao.RemoveTag("p", "TREASURY") || dao.RemoveTag("p", "treasury") {
+		t.Fatal("RemoveTag should succeed once")
+	}
+}
@@ -0,0 +1,27 @@
+package reputation
+
+import "testing"
+
+func TestRecomputeTallyRestoresDriftedTotals(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 81, "b": 100, "c": 95})
+	dao.ProposeRule("p", "a valid description", "c")
+	dao.Vote("p", "a", true, 2)
+	dao.Vote("p", "b", false, 1)
+	dao.VoteAbstain("p", "c", 1)
+	prop := dao.GetProposal("p")
+	want := [3]float64{prop.VotesFor, prop.VotesAgainst, prop.VotesAbstain}
+	if want[0] != 18 || want[1] != 10 {
+		t.Fatalf("tally = %v, want 2*sqrt(81) for and sqrt(100) against", want)
+	}
+	prop.VotesFor, prop.VotesAbstain = 999, -3
+	prop.ForWeight = 1
+	if !dao.RecomputeTally("p") {
+		t.Fatal("RecomputeTally refused")
+	}
+	if got := [3]float64{prop.VotesFor, prop.VotesAgainst, prop.VotesAbstain}; got != want {
+		t.Fatalf("recomputed tally = %v, want %v", got, want)
+	}
+	if dao.RecomputeTally("missing") {
+		t.Fatal("RecomputeTally accepted an unknown proposal")
+	}
+}
@@ -0,0 +1,40 @@
+package reputation
+
+import "strconv"
+
+// CloseVoting ends voting on an active proposal and freezes its tally,
+// moving it to StatusTallying; Enact later judges the frozen numbers. It
+// closes no earlier than Enact would: only once the deadline has passed or
+// while the proposal is already passing, and never while commitments can
+// still be revealed.
+func (d *DAOContract) CloseVoting(proposalID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("CloseVoting", proposalID)
+	prop, exists := d.lookup(proposalID)
+	if !exists || prop.Status != StatusActive {
+		return false
+	}
+	now := d.clock.Now()
+	if d.revealPending(prop, now) {
+		return false
+	}
+	results := d.tally(prop)
+	if !results.finite() || (!prop.votingClosed(now) && !results.Passes) {
+		return false
+	}
+	prop.ClosedTally = &results
+	prop.setStatus(StatusTallying)
+	d.emit(Event{
+		Type:    EventVotingClosed,
+		Subject: prop.ID,
+		Details: map[string]string{"voters": strconv.Itoa(results.Voters)},
+	})
+	d.logger.Info("voting_closed", "proposal", prop.ID, "for", results.VotesFor, "against", results.VotesAgainst)
+	return true
+}
+
+// revealPending reports whether committed votes on prop may still be revealed.
+func (d *DAOContract) revealPending(prop *Proposal, now int) bool {
+	return len(prop.Commitments) > 0 && prop.Deadline > 0 && now < prop.Deadline+d.revealWindow
+}
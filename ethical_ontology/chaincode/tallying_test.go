@@ -0,0 +1,65 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCloseVotingFreezesTally(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90, "c": 90})
+	dao.SetVotingPeriod(10)
+	dao.ProposeRule("p", "a valid description", "a")
+	dao.Vote("p", "a", false, 1)
+	if dao.CloseVoting("p") {
+		t.Fatal("closed early on a failing proposal")
+	}
+	dao.Vote("p", "b", true, 2)
+	if !dao.CloseVoting("p") || dao.GetProposal("p").Status != StatusTallying {
+		t.Fatal("passing proposal not moved to tallying")
+	}
+	if err := dao.VoteChecked("p", "c", VoteAgainst, 5); !errors.Is(err, ErrVotingClosed) {
+		t.Fatalf("got %v, want ErrVotingClosed", err)
+	}
+	if dao.AmendProposal("p", "a", "reworded description") {
+		t.Fatal("amended after voting closed")
+	}
+	if errs := dao.VerifyInvariants(); errs != nil {
+		t.Fatal(errs)
+	}
+	// Enactment uses the frozen tally, not current reputation
+	rep.Slash("b", 80, "misconduct")
+	if err := dao.EnactChecked("p"); err != nil {
+		t.Fatal(err)
+	}
+	if prop := dao.GetProposal("p"); prop.Status != StatusPassed || prop.ClosedTally != nil {
+		t.Fatalf("status %v, closed tally %v", prop.Status, prop.ClosedTally)
+	}
+	if _, _, err := ReplayOperations(dao.OperationLog()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClosedTallySurvivesState(t *testing.T) {
+	dao, rep, _ := newTestDAO(t, 0.5, map[string]int{"a": 90})
+	dao.ProposeRule("p", "a valid description", "a")
+	dao.Vote("p", "a", true, 1)
+	if !dao.CloseVoting("p") {
+		t.Fatal("CloseVoting refused")
+	}
+	blob, _ := dao.MarshalBinary()
+	fromBinary := NewDAOContract(rep, 0.5)
+	if err := fromBinary.UnmarshalBinary(blob); err != nil {
+		t.Fatal(err)
+	}
+	if tally := fromBinary.GetProposal("p").ClosedTally; tally == nil || tally.VotesFor != dao.GetProposal("p").VotesFor {
+		t.Fatalf("closed tally after binary round trip: %v", tally)
+	}
+	blob, _ = dao.MarshalJSON()
+	fromJSON := NewDAOContract(rep, 0.5)
+	if err := fromJSON.UnmarshalJSON(blob); err != nil {
+		t.Fatal(err)
+	}
+	if !fromJSON.Enact("p") {
+		t.Fatal("closed proposal not enactable after JSON round trip")
+	}
+}
@@ -0,0 +1,59 @@
+package reputation
+
+import "strconv"
+
+// TiePolicy decides what Enact does when for and against weigh exactly the
+// same.
+type TiePolicy int
+
+const (
+	// TiePasses leaves a tie to the approval threshold, under which it passes
+	// whenever the quorum is at most one half. It is the default.
+	TiePasses TiePolicy = iota
+	// TieFails never lets a tie pass.
+	TieFails
+	// TieExtends treats a tie as undecided: once voting has closed, Enact
+	// reopens it for the configured extension instead of rejecting.
+	TieExtends
+)
+
+// SetTiePolicy chooses how exact ties are settled. extension is the number
+// of seconds TieExtends adds to the voting period, counted from the Enact
+// call that found the tie; it is ignored by the other policies.
+func (d *DAOContract) SetTiePolicy(policy TiePolicy, extension int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetTiePolicy", policy, extension)
+	d.tiePolicy = policy
+	d.tieExtension = extension
+}
+
+// breakTie applies the tie policy to a verdict; results must already carry
+// the policy's outcome.
+func (d *DAOContract) breakTie(results ProposalResults) ProposalResults {
+	results.Tied = results.VotesFor > 0 && results.VotesFor == results.VotesAgainst
+	if results.Tied && results.Passes && d.tiePolicy != TiePasses {
+		results.Passes = false
+		results.Reason = "for and against are tied"
+	}
+	return results
+}
+
+// extendTie reopens a closed, tied proposal under TieExtends, reporting
+// whether it did so.
+func (d *DAOContract) extendTie(prop *Proposal, results ProposalResults, now int) bool {
+	if d.tiePolicy != TieExtends || !results.Tied || !results.TurnoutMet || d.tieExtension <= 0 {
+		return false
+	}
+	prop.Deadline = now + d.tieExtension
+	if prop.Status == StatusTallying {
+		prop.setStatus(StatusActive)
+	}
+	d.emit(Event{
+		Type:    EventVotingExtended,
+		Subject: prop.ID,
+		Details: map[string]string{"deadline": strconv.Itoa(prop.Deadline)},
+	})
+	d.logger.Info("voting_extended", "proposal", prop.ID, "deadline", prop.Deadline)
+	return true
+}
@@ -0,0 +1,57 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTiePolicies(t *testing.T) {
+	for _, tc := range []struct {
+		policy TiePolicy
+		want   error
+		status ProposalStatus
+	}{
+		{TiePasses, nil, StatusPassed},
+		{TieFails, ErrNotPassing, StatusRejected},
+		{TieExtends, ErrVotingExtended, StatusActive},
+	} {
+		dao, _, clock := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90})
+		dao.SetVotingPeriod(10)
+		dao.SetTiePolicy(tc.policy, 50)
+		dao.ProposeRule("p", "a valid description", "a")
+		dao.Vote("p", "a", true, 1)
+		dao.Vote("p", "b", false, 1)
+		clock.now = 20
+		if err := dao.EnactChecked("p"); !errors.Is(err, tc.want) {
+			t.Fatalf("policy %v: got %v, want %v", tc.policy, err, tc.want)
+		}
+		if status := dao.GetProposal("p").Status; status != tc.status {
+			t.Fatalf("policy %v: status %v, want %v", tc.policy, status, tc.status)
+		}
+		if _, _, err := ReplayOperations(dao.OperationLog()); err != nil {
+			t.Fatalf("policy %v: replay: %v", tc.policy, err)
+		}
+	}
+}
+
+func TestTieExtensionReopensVoting(t *testing.T) {
+	dao, rep, clock := newTestDAO(t, 0.5, map[string]int{"a": 90, "b": 90})
+	dao.SetVotingPeriod(10)
+	dao.SetTiePolicy(TieExtends, 50)
+	dao.ProposeRule("p", "a valid description", "a")
+	dao.Vote("p", "a", true, 1)
+	dao.Vote("p", "b", false, 1)
+	clock.now = 20
+	dao.EnactChecked("p")
+	if deadline := dao.GetProposal("p").Deadline; deadline != 70 {
+		t.Fatalf("deadline %d, want 70", deadline)
+	}
+	rep.MintToken("c", 90)
+	if !dao.Vote("p", "c", true, 1) {
+		t.Fatal("vote refused during the extension")
+	}
+	clock.now = 80
+	if err := dao.EnactChecked("p"); err != nil {
+		t.Fatal(err)
+	}
+}
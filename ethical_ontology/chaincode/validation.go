@@ -0,0 +1,46 @@
+package reputation
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DescriptionRules bounds proposal descriptions. Lengths are in characters
+// after trimming surrounding whitespace; zero leaves that bound off.
+type DescriptionRules struct {
+	MinLength          int
+	MaxLength          int
+	RejectControlChars bool // tabs and newlines are always allowed
+}
+
+var DefaultDescriptionRules = DescriptionRules{
+	MaxLength:          5000,
+	RejectControlChars: true,
+}
+
+func (d *DAOContract) SetDescriptionRules(rules DescriptionRules) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.record("SetDescriptionRules", rules)
+	d.descriptionRules = rules
+}
+
+func (r DescriptionRules) validate(description string) error {
+	length := utf8.RuneCountInString(strings.TrimSpace(description))
+	if length < r.MinLength {
+		return fmt.Errorf("%w: %d characters, minimum is %d", ErrInvalidDescription, length, r.MinLength)
+	}
+	if r.MaxLength > 0 && length > r.MaxLength {
+		return fmt.Errorf("%w: %d characters, maximum is %d", ErrInvalidDescription, length, r.MaxLength)
+	}
+	if r.RejectControlChars {
+		for _, ch := range description {
+			if unicode.IsControl(ch) && ch != '\n' && ch != '\r' && ch != '\t' {
+				return fmt.Errorf("%w: contains control character %U", ErrInvalidDescription, ch)
+			}
+		}
+	}
+	return nil
+}
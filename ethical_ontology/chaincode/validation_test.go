@@ -0,0 +1,33 @@
+package reputation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDescriptionRules(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.6, map[string]int{"f": 81})
+	dao.SetDescriptionRules(DescriptionRules{MinLength: 5, MaxLength: 20, RejectControlChars: true})
+	for _, bad := range []string{"", "   ab  ", strings.Repeat("a", 21), "hello\x00world"} {
+		if err := dao.ProposeRuleChecked("x", bad, "f"); !errors.Is(err, ErrInvalidDescription) {
+			t.Fatalf("description %q: got %v, want ErrInvalidDescription", bad, err)
+		}
+	}
+	if err := dao.ProposeRuleChecked("ok", "fine text\nhere", "f"); err != nil {
+		t.Fatalf("valid description refused: %v", err)
+	}
+	if err := dao.ProposeRuleChecked("ok", "fine text\nhere", "f"); !errors.Is(err, ErrProposalExists) {
+		t.Fatalf("reused ID: got %v, want ErrProposalExists", err)
+	}
+}
+
+func TestDefaultDescriptionRulesSetNoMinimum(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.6, map[string]int{"f": 81})
+	if err := dao.ProposeRuleChecked("short", "fix", "f"); err != nil {
+		t.Fatalf("short description refused by default: %v", err)
+	}
+	if err := dao.ProposeRuleChecked("long", strings.Repeat("a", 5001), "f"); !errors.Is(err, ErrInvalidDescription) {
+		t.Fatalf("overlong description: got %v, want ErrInvalidDescription", err)
+	}
+}
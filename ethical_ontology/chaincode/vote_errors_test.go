@@ -0,0 +1,30 @@
+package reputation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMissingAndInactiveProposalErrors(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.6, map[string]int{"f": 81, "g": 81})
+	dao.ProposeRule("p", "a valid description", "f")
+	if err := dao.VoteChecked("nope", "f", VoteFor, 1); !errors.Is(err, ErrProposalNotFound) {
+		t.Fatalf("vote on a missing proposal: %v", err)
+	}
+	if err := dao.EnactChecked("nope"); !errors.Is(err, ErrProposalNotFound) {
+		t.Fatalf("enact a missing proposal: %v", err)
+	}
+	if err := dao.EnactChecked("p"); !errors.Is(err, ErrNotPassing) {
+		t.Fatalf("enact without votes: %v", err)
+	}
+	dao.Vote("p", "f", true, 1)
+	if err := dao.EnactChecked("p"); err != nil {
+		t.Fatalf("enact a passing proposal: %v", err)
+	}
+	if err := dao.VoteChecked("p", "g", VoteFor, 1); !errors.Is(err, ErrProposalInactive) {
+		t.Fatalf("vote on an enacted proposal: %v", err)
+	}
+	if err := dao.EnactChecked("p"); !errors.Is(err, ErrProposalInactive) {
+		t.Fatalf("enact twice: %v", err)
+	}
+}
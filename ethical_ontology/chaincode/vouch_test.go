@@ -0,0 +1,27 @@
+package reputation
+
+import "testing"
+
+func TestMintRequiresVouches(t *testing.T) {
+	rep := NewReputationContract()
+	rep.MintToken("a", 90)
+	rep.MintToken("b", 90)
+	rep.MintToken("low", 81)
+	rep.SetVouchRequirement(2, 85)
+	if !rep.VouchForMint("c", "a") {
+		t.Fatal("eligible voucher refused")
+	}
+	if rep.VouchForMint("c", "a") {
+		t.Fatal("same voucher counted twice")
+	}
+	if rep.VouchForMint("c", "low") {
+		t.Fatal("voucher below the minimum reputation accepted")
+	}
+	if rep.MintToken("c", 90) {
+		t.Fatal("minted with one vouch of two")
+	}
+	rep.VouchForMint("c", "b")
+	if !rep.MintToken("c", 90) {
+		t.Fatal("mint refused with enough vouches")
+	}
+}
@@ -0,0 +1,61 @@
+package reputation
+
+import "sync"
+
+// WatchProposal streams fresh results for proposalID whenever its tally
+// changes, starting with the current results. The channel holds only the
+// latest snapshot, so a slow reader skips intermediate updates rather than
+// building a backlog. Call the returned function to stop watching; the
+// channel is then closed. An unknown proposal yields a closed channel.
+func (d *DAOContract) WatchProposal(id string) (<-chan ProposalResults, func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	ch := make(chan ProposalResults, 1)
+	prop, exists := d.lookup(id)
+	if !exists {
+		close(ch)
+		return ch, func() {}
+	}
+	// Keyed like notifyWatchers, by the canonical ID rather than as typed
+	id = prop.ID
+	d.nextWatcherID++
+	watcherID := d.nextWatcherID
+	if d.watchers[id] == nil {
+		d.watchers[id] = make(map[int]chan ProposalResults)
+	}
+	d.watchers[id][watcherID] = ch
+	ch <- d.tally(prop)
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+			delete(d.watchers[id], watcherID)
+			if len(d.watchers[id]) == 0 {
+				delete(d.watchers, id)
+			}
+			close(ch)
+		})
+	}
+}
+
+// notifyWatchers publishes the latest results, replacing any unread snapshot.
+// Callers hold d.mu, so sends never race with the cancel func's close.
+func (d *DAOContract) notifyWatchers(prop *Proposal) {
+	watchers := d.watchers[prop.ID]
+	if len(watchers) == 0 {
+		return
+	}
+	results := d.tally(prop)
+	for _, ch := range watchers {
+		select {
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- results:
+		default:
+		}
+	}
+}
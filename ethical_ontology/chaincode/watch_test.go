@@ -0,0 +1,66 @@
+package reputation
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestWatchProposalKeepsLatestResults(t *testing.T) {
+	scores := make(map[string]int)
+	for i := 0; i < 5; i++ {
+		scores[fmt.Sprint("a", i)] = 81
+	}
+	dao, _, _ := newTestDAO(t, 0.5, scores)
+	dao.ProposeRule("p", "a valid description", "a0")
+	updates, stop := dao.WatchProposal("p")
+	for i := 0; i < 5; i++ {
+		dao.Vote("p", fmt.Sprint("a", i), true, 1)
+	}
+	if results := <-updates; results.VotesFor != 45 {
+		t.Fatalf("latest update has VotesFor %v, want 45", results.VotesFor)
+	}
+	stop()
+	stop()
+	if _, open := <-updates; open {
+		t.Fatal("channel still open after stop")
+	}
+}
+
+func TestWatchProposalNormalizesID(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 81})
+	dao.SetIDRules(IDRules{Trim: true, CaseFold: true})
+	dao.ProposeRule("Prop1", "a valid description", "a")
+	updates, stop := dao.WatchProposal(" PROP1 ")
+	defer stop()
+	<-updates
+	dao.Vote("prop1", "a", true, 1)
+	select {
+	case results := <-updates:
+		if results.Voters != 1 {
+			t.Fatalf("update shows %d voters, want 1", results.Voters)
+		}
+	default:
+		t.Fatal("no update after a vote")
+	}
+}
+
+func TestWatchProposalIndependentWatchers(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"a": 81, "b": 81})
+	missing, _ := dao.WatchProposal("missing")
+	if _, open := <-missing; open {
+		t.Fatal("watching an unknown proposal left the channel open")
+	}
+	dao.ProposeRule("p", "a valid description", "a")
+	first, stopFirst := dao.WatchProposal("p")
+	second, stopSecond := dao.WatchProposal("p")
+	defer stopSecond()
+	<-first
+	<-second
+	stopFirst()
+	// Votes after one watcher stops still reach the other
+	dao.Vote("p", "a", true, 1)
+	dao.Vote("p", "b", false, 1)
+	if results := <-second; results.Voters != 2 {
+		t.Fatalf("remaining watcher saw %d voters, want 2", results.Voters)
+	}
+}
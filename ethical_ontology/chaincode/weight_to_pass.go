@@ -0,0 +1,51 @@
+package reputation
+
+// maxExtraWeight bounds the search in WeightToPass; a proposal that can't
+// pass with this much more for-weight is treated as unreachable by weight.
+const maxExtraWeight = 1e12
+
+// WeightToPass reports how much more for-weight (quadratic weight, as in
+// VotesFor) the proposal needs before Enact would pass it, judged by the
+// same policy and thresholds. It returns 0 and true if it already passes,
+// and false if the proposal is no longer taking votes or extra for-weight alone can't
+// pass it, such as when more voters or reputation share are required. The
+// extra weight is assumed to come from voters already counted.
+func (d *DAOContract) WeightToPass(proposalID string) (float64, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	prop, err := d.activeProposal(proposalID)
+	if err != nil {
+		return 0, false
+	}
+	current := d.tally(prop)
+	if current.Passes {
+		return 0, true
+	}
+	if !current.finite() || prop.Status == StatusTallying {
+		return 0, false
+	}
+	passesWith := func(extra float64) bool {
+		r := current
+		r.VotesFor += extra
+		// A ballot cast now has full recency weight
+		r.Turnout += extra
+		return d.judge(prop, r).Passes
+	}
+	hi := 1.0 / WeightScale
+	for !passesWith(hi) {
+		if hi *= 2; hi > maxExtraWeight {
+			return 0, false
+		}
+	}
+	// Narrow to fixed-point resolution, rounding up so the answer suffices
+	lo := 0.0
+	for hi-lo > 1.0/WeightScale {
+		mid := (lo + hi) / 2
+		if passesWith(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, true
+}
@@ -0,0 +1,25 @@
+package reputation
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWeightToPass(t *testing.T) {
+	dao, _, _ := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 100})
+	dao.SetAgainstMultiplier(2)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "g", false, 1) // 10 against, doubled
+	if w, ok := dao.WeightToPass("p"); !ok || math.Abs(w-20) > 1e-5 {
+		t.Fatalf("WeightToPass = %v, %v; want about 20", w, ok)
+	}
+	dao.Vote("p", "f", true, 3) // 27 for
+	if w, ok := dao.WeightToPass("p"); !ok || w != 0 {
+		t.Fatalf("already passing: %v, %v", w, ok)
+	}
+	// More weight can't supply missing voters
+	dao.SetMinVoters(5)
+	if _, ok := dao.WeightToPass("p"); ok {
+		t.Fatal("weight alone reported as enough to meet a voter minimum")
+	}
+}
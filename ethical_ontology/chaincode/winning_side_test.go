@@ -0,0 +1,27 @@
+package reputation
+
+import "testing"
+
+func TestWinningSideReward(t *testing.T) {
+	dao, rep, clock := newTestDAO(t, 0.5, map[string]int{"f": 81, "g": 90, "h": 90})
+	dao.SetWinningSideReward(2)
+	dao.ProposeRule("p", "a valid description", "f")
+	dao.Vote("p", "f", true, 1)
+	dao.Vote("p", "g", true, 1)
+	dao.Vote("p", "h", false, 1)
+	dao.Enact("p")
+	if f, g, h := rep.GetReputation("f"), rep.GetReputation("g"), rep.GetReputation("h"); f != 83 || g != 92 || h != 90 {
+		t.Fatalf("after enactment f=%d g=%d h=%d, want 83 92 90", f, g, h)
+	}
+	clock.now = 100
+	dao.SetVotingPeriod(10)
+	dao.ProposeRule("q", "b valid description", "f")
+	dao.Vote("q", "f", true, 1)
+	dao.Vote("q", "g", false, 1)
+	dao.Vote("q", "h", false, 1)
+	clock.now = 200
+	dao.Enact("q")
+	if f, g, h := rep.GetReputation("f"), rep.GetReputation("g"), rep.GetReputation("h"); f != 83 || g != 94 || h != 92 {
+		t.Fatalf("after rejection f=%d g=%d h=%d, want 83 94 92", f, g, h)
+	}
+}